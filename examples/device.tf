@@ -0,0 +1,219 @@
+resource "ftd_device_settings" "device" {
+  hostname       = "ftd-dc-01"
+  loginbanner    = "Authorized access only"
+  consoletimeout = 15
+  webanalytics   = false
+}
+
+resource "ftd_ntp_settings" "ntp" {
+  timezone         = "Europe/Berlin"
+  reset_on_destroy = true
+
+  ntpservers {
+    server = "10.0.0.123"
+    keyid  = 10
+    key    = var.ntp_key
+  }
+  ntpservers {
+    server = "0.sourcefire.pool.ntp.org"
+  }
+}
+
+variable "ntp_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_dhcp_server" "dhcp" {
+  autoconfig = true
+  autoconfiginterface {
+    id   = ftd_interface.outside.id
+    name = ftd_interface.outside.name
+  }
+
+  servers {
+    interface {
+      id   = ftd_interface.inside.id
+      name = ftd_interface.inside.name
+    }
+    addresspoolstart = "192.168.45.10"
+    addresspoolend   = "192.168.45.200"
+  }
+}
+
+resource "ftd_management_access" "inside_mgmt" {
+  interface {
+    id   = ftd_interface.inside.id
+    name = ftd_interface.inside.name
+  }
+  protocols = ["HTTPS", "SSH"]
+
+  networkobjects {
+    id   = ftd_network_object.tf_ip_address.id
+    name = ftd_network_object.tf_ip_address.name
+  }
+}
+
+resource "ftd_snmp_server" "snmp" {
+  location = "DC1 rack 12"
+  contact  = "noc@example.com"
+
+  users {
+    name                    = "monitoring"
+    securitylevel           = "PRIV"
+    authenticationalgorithm = "SHA256"
+    authenticationpassword  = var.snmp_auth_key
+    encryptionalgorithm     = "AES256"
+    encryptionpassword      = var.snmp_priv_key
+  }
+}
+
+resource "ftd_snmp_host" "nms" {
+  name = "nms"
+  manageraddress {
+    id   = ftd_network_object.tf_ip_address.id
+    name = ftd_network_object.tf_ip_address.name
+  }
+  interface {
+    id   = ftd_interface.inside.id
+    name = ftd_interface.inside.name
+  }
+  snmpuser {
+    id   = ftd_snmp_server.snmp.users[0].id
+    name = ftd_snmp_server.snmp.users[0].name
+  }
+}
+
+variable "snmp_auth_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "snmp_priv_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_ha_configuration" "pair" {
+  noderole = "HA_PRIMARY"
+
+  failoverinterface {
+    id = var.failover_interface_id
+  }
+  failovername          = "failover-link"
+  primaryfailoveripv4   = "192.168.100.1"
+  secondaryfailoveripv4 = "192.168.100.2"
+  failovernetmask       = "255.255.255.252"
+  sharedkey             = var.ha_key
+
+  interfacefailurethreshold = 1
+  interfacefailureunit      = "NUMBER"
+
+  timeouts {
+    create = "45m"
+  }
+}
+
+variable "failover_interface_id" {
+  type = string
+}
+
+variable "ha_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_smart_license" "device" {
+  connectiontype    = "REGISTER"
+  registrationtoken = var.smart_license_token
+}
+
+resource "ftd_license_feature" "threat" {
+  licensetype = "THREAT"
+  depends_on  = [ftd_smart_license.device]
+}
+
+data "ftd_license_status" "required" {
+  requiredlicenses = ["THREAT"]
+  depends_on       = [ftd_license_feature.threat]
+}
+
+variable "smart_license_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_backup" "before_change" {
+  description  = "taken by terraform before apply"
+  downloadpath = "${path.module}/backups/ftd.tar"
+
+  triggers = {
+    policy_version = ftd_access_policy.defaul_access_rule.version
+  }
+}
+
+resource "ftd_backup_schedule" "weekly" {
+  name       = "weekly"
+  frequency  = "WEEKLY"
+  runtime    = "02:00"
+  daysofweek = ["SUNDAY"]
+}
+
+resource "ftd_local_user" "breakglass" {
+  name            = "breakglass"
+  password        = var.breakglass_password
+  passwordversion = "2026-10"
+  role            = "ROLE_ADMIN"
+  servicetypes    = ["MCV"]
+}
+
+variable "breakglass_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_radius_server" "ise1" {
+  name   = "ise1"
+  host   = "10.10.5.10"
+  secret = var.radius_secret
+}
+
+resource "ftd_radius_server_group" "ise" {
+  name = "ise"
+
+  servers {
+    id   = ftd_radius_server.ise1.id
+    name = ftd_radius_server.ise1.name
+  }
+
+  dynamicauthorization = true
+}
+
+resource "ftd_external_auth" "ssh" {
+  protocol = "SSH"
+
+  servergroup {
+    id   = ftd_radius_server_group.ise.id
+    name = ftd_radius_server_group.ise.name
+  }
+}
+
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_internal_certificate" "web" {
+  name       = "fdm-web"
+  cert       = file("${path.module}/certs/fdm.pem")
+  privatekey = file("${path.module}/certs/fdm.key")
+}
+
+resource "ftd_trusted_ca_certificate" "corp_root" {
+  name = "corp-root-ca"
+  cert = file("${path.module}/certs/corp-root.pem")
+}
+
+data "ftd_certificate" "default" {
+  name = "DefaultInternalCertificate"
+}
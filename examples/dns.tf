@@ -0,0 +1,38 @@
+resource "ftd_dns_server_group" "corp_dns" {
+  name         = "corp_dns"
+  searchdomain = "corp.example.com"
+  timeout      = 2
+  retries      = 2
+
+  dnsservers {
+    ipaddress = "10.0.0.53"
+  }
+  dnsservers {
+    ipaddress = "10.0.1.53"
+  }
+}
+
+resource "ftd_dns_settings" "dns" {
+  managementdnsservergroup {
+    id   = ftd_dns_server_group.corp_dns.id
+    name = ftd_dns_server_group.corp_dns.name
+  }
+
+  datadnsservergroup {
+    id   = ftd_dns_server_group.corp_dns.id
+    name = ftd_dns_server_group.corp_dns.name
+  }
+
+  datainterfaces {
+    id   = ftd_interface.outside.id
+    name = ftd_interface.outside.name
+    type = ftd_interface.outside.type
+  }
+}
+
+resource "ftd_network_object" "tf_fqdn" {
+  name          = "updates_fqdn"
+  subtype       = "FQDN"
+  value         = "updates.example.com"
+  dnsresolution = "IPV4_ONLY"
+}
@@ -9,9 +9,21 @@ terraform {
 }
 
 provider "ftd" {
+    # in CI leave username and password out and use credentials_file,
+    # credential_helper = ["vault-ftd-creds", "--role", "ci"] or a pre-issued FTD_TOKEN instead
     username = "admin"
     password = "Cisco_1234"
     url = "https://10.100.16.210"
+    # lab device with the self-signed DefaultInternalCertificate,
+    # use ca_cert_file and tls_server_name for verified connections
+    insecure_skip_verify = true
+
+    # further firewalls, picked with the device attribute of resources and data sources.
+    # username and password default to the ones above
+    device {
+      name = "branch1"
+      url  = "https://10.100.17.210"
+    }
 }
 
 resource "ftd_security_zone" "ft_sz" {
@@ -39,6 +51,14 @@ resource "ftd_network_object" "tf_ip_address" {
   value = "10.0.0.1"
   type = "networkobject"
 }
+
+resource "ftd_network_object" "branch1_ip_address" {
+  device = "branch1"
+  name = "ip_from_terraform"
+  subtype = "HOST"
+  value = "10.0.1.1"
+  type = "networkobject"
+}
 /*
 resource "ftd_security_zone" "ft_sz_default_outside" {
   name = "outside_zone"
@@ -0,0 +1,132 @@
+resource "ftd_network_object" "dc_core" {
+  name    = "dc_core"
+  subtype = "NETWORK"
+  value   = "10.10.0.0/16"
+}
+
+resource "ftd_ospf" "dc" {
+  name      = "dc_ospf"
+  processid = 1
+  routerid  = "10.10.255.1"
+
+  areas {
+    areaid = "0"
+    networks {
+      id   = ftd_network_object.dc_core.id
+      name = ftd_network_object.dc_core.name
+    }
+  }
+
+  interfacesettings {
+    interface {
+      id   = ftd_interface.inside.id
+      name = ftd_interface.inside.name
+    }
+    cost              = 20
+    priority          = 0
+    authentication    = "MD5"
+    md5keyid          = 1
+    authenticationkey = var.ospf_key
+  }
+
+  redistribute {
+    protocol = "CONNECTED"
+    routemap {
+      id   = ftd_route_map.dc_export.id
+      name = ftd_route_map.dc_export.name
+    }
+  }
+
+  defaultinformationoriginate {
+    alwaysadvertise = true
+  }
+}
+
+variable "ospf_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_bgp_general_settings" "bgp" {
+  asnumber = "65001"
+  routerid = "10.10.255.1"
+}
+
+resource "ftd_bgp" "wan" {
+  name     = "wan_bgp"
+  asnumber = ftd_bgp_general_settings.bgp.asnumber
+
+  networks {
+    network {
+      id   = ftd_network_object.dc_core.id
+      name = ftd_network_object.dc_core.name
+    }
+  }
+
+  neighbors {
+    address  = "192.168.33.1"
+    remoteas = "65010"
+    password = var.bgp_password
+    prefixlistout {
+      id   = ftd_prefix_list.dc_prefixes.id
+      name = ftd_prefix_list.dc_prefixes.name
+    }
+  }
+}
+
+variable "bgp_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "ftd_standard_access_list" "dc_core" {
+  name = "dc_core_acl"
+
+  entries {
+    action = "PERMIT"
+    networks {
+      id   = ftd_network_object.dc_core.id
+      name = ftd_network_object.dc_core.name
+    }
+  }
+}
+
+resource "ftd_prefix_list" "dc_prefixes" {
+  name = "dc_prefixes"
+  type = "ipv4prefixlist"
+
+  entries {
+    sequencenumber  = 10
+    action          = "PERMIT"
+    ipaddress       = "10.10.0.0/16"
+    maxprefixlength = 24
+  }
+}
+
+resource "ftd_as_path_list" "upstream" {
+  name = "10"
+
+  entries {
+    action            = "PERMIT"
+    regularexpression = "^65010_"
+  }
+}
+
+resource "ftd_route_map" "dc_export" {
+  name = "dc_export"
+
+  entries {
+    sequencenumber = 10
+    action         = "PERMIT"
+    matchaccesslists {
+      id   = ftd_standard_access_list.dc_core.id
+      name = ftd_standard_access_list.dc_core.name
+    }
+    setmetric = 100
+  }
+
+  entries {
+    sequencenumber = 20
+    action         = "DENY"
+  }
+}
@@ -0,0 +1,145 @@
+package ftd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// ftd-client only wraps the objects the provider started with. Endpoints it does not
+// cover yet are called through doRequest, which reuses the client's URL, HTTP client and token.
+
+// listItems - list return wrapper for models defined in this package
+type listItems[T any] struct {
+	Items  []T         `json:"items"`
+	Paging ftdc.Paging `json:"paging"`
+}
+
+// notFoundError - FDM answered 404, e.g. the object was deleted outside of terraform
+type notFoundError struct {
+	Body string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", http.StatusNotFound, e.Body)
+}
+
+// isNotFound reports whether err, or an error it wraps, is a notFoundError
+func isNotFound(err error) bool {
+	var nf *notFoundError
+	return errors.As(err, &nf)
+}
+
+// readError clears the ID of an object that no longer exists, so terraform plans to create it again.
+// Any other error is returned as is.
+func readError(d *schema.ResourceData, err error) diag.Diagnostics {
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+	return diag.FromErr(err)
+}
+
+// deleteError treats an object already deleted outside of terraform as deleted.
+// Any other error is returned as is.
+func deleteError(err error) diag.Diagnostics {
+	if isNotFound(err) {
+		return nil
+	}
+	return diag.FromErr(err)
+}
+
+func doRequest[T any](c *ftdc.Client, m *T, path string, method string) error {
+	URL := fmt.Sprintf("%s/api/fdm/v6/%s", c.FTDURL, strings.TrimPrefix(path, "/"))
+
+	var rb io.Reader
+	if method == http.MethodPost || method == http.MethodPut {
+		b, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		rb = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, URL, rb)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.AuthResponse.AccessToken))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	}
+
+	// Cisco FTD does not return data on delete
+	if len(body) > 0 && method != http.MethodDelete {
+		return json.Unmarshal(body, m)
+	}
+
+	return nil
+}
+
+// getSingleton returns the only item of a device settings list, e.g. devicesettings/default/devicednssettings
+func getSingleton[T any](c *ftdc.Client, path string) (*T, error) {
+	items := listItems[T]{}
+	err := doRequest(c, &items, path, http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	if len(items.Items) == 0 {
+		return nil, fmt.Errorf("no object returned by %s", path)
+	}
+	return &items.Items[0], nil
+}
+
+// downloadFile writes a binary response, e.g. a backup archive, to w
+func downloadFile(c *ftdc.Client, path string, w io.Writer) error {
+	URL := fmt.Sprintf("%s/api/fdm/v6/%s", c.FTDURL, strings.TrimPrefix(path, "/"))
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.AuthResponse.AccessToken))
+	req.Header.Set("Accept", "application/octet-stream")
+
+	// archives take longer than the client timeout meant for API calls
+	httpClient := *c.HTTPClient
+	httpClient.Timeout = 0
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	}
+
+	_, err = io.Copy(w, res.Body)
+	return err
+}
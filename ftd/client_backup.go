@@ -0,0 +1,76 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type backupImmediate struct {
+	ID                string `json:"id,omitempty"`
+	Version           string `json:"version,omitempty"`
+	Name              string `json:"name,omitempty"`
+	BackupDescription string `json:"backupDescription,omitempty"`
+	JobHistoryUuid    string `json:"jobHistoryUuid,omitempty"`
+	Type              string `json:"type"` //backupimmediate
+}
+
+type backupJob struct {
+	ID            string `json:"id,omitempty"`
+	JobName       string `json:"jobName,omitempty"`
+	Status        string `json:"status"` //['QUEUED', 'IN_PROGRESS', 'SUCCESS', 'FAILED']
+	StatusMessage string `json:"statusMessage,omitempty"`
+	ArchiveName   string `json:"archiveName,omitempty"`
+	StartDateTime string `json:"startDateTime,omitempty"`
+	EndDateTime   string `json:"endDateTime,omitempty"`
+	Type          string `json:"type"` //backupjobhistory
+}
+
+type scheduledBackup struct {
+	ID                string   `json:"id,omitempty"`
+	Version           string   `json:"version,omitempty"`
+	Name              string   `json:"name"`
+	BackupDescription string   `json:"backupDescription,omitempty"`
+	ScheduleType      string   `json:"scheduleType"` //['DAILY', 'WEEKLY', 'MONTHLY']
+	RunTimes          string   `json:"runTimes"`     //HH:MM in UTC
+	DaysOfWeek        []string `json:"daysOfWeek,omitempty"`
+	DayOfMonth        int      `json:"dayOfMonth,omitempty"`
+	Type              string   `json:"type"` //scheduledbackup
+}
+
+func createBackupImmediate(c *ftdc.Client, b backupImmediate) (*backupImmediate, error) {
+	err := doRequest(c, &b, "action/backup", http.MethodPost)
+	return &b, err
+}
+
+func getBackupJob(c *ftdc.Client, ID string) (*backupJob, error) {
+	j := backupJob{}
+	err := doRequest(c, &j, fmt.Sprintf("jobs/backups/%s", ID), http.MethodGet)
+	return &j, err
+}
+
+func getScheduledBackup(c *ftdc.Client, ID string) (*scheduledBackup, error) {
+	b := scheduledBackup{}
+	err := doRequest(c, &b, fmt.Sprintf("action/scheduledbackup/%s", ID), http.MethodGet)
+	return &b, err
+}
+
+func createScheduledBackup(c *ftdc.Client, b scheduledBackup) (*scheduledBackup, error) {
+	err := doRequest(c, &b, "action/scheduledbackup", http.MethodPost)
+	return &b, err
+}
+
+func updateScheduledBackup(c *ftdc.Client, b scheduledBackup) (*scheduledBackup, error) {
+	err := doRequest(c, &b, fmt.Sprintf("action/scheduledbackup/%s", b.ID), http.MethodPut)
+	return &b, err
+}
+
+func deleteScheduledBackup(c *ftdc.Client, b scheduledBackup) error {
+	return doRequest(c, &b, fmt.Sprintf("action/scheduledbackup/%s", b.ID), http.MethodDelete)
+}
+
+func backupArchivePath(archiveName string) string {
+	return fmt.Sprintf("action/downloadbackup/%s", url.PathEscape(archiveName))
+}
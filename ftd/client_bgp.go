@@ -0,0 +1,140 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// bgpGeneralSettings - device wide BGP settings, only one can exist
+type bgpGeneralSettings struct {
+	ID                   string    `json:"id,omitempty"`
+	Version              string    `json:"version,omitempty"`
+	Name                 string    `json:"name"`
+	AsNumber             string    `json:"asNumber"`
+	RouterId             string    `json:"routerId,omitempty"`
+	ScanTime             int       `json:"scanTime,omitempty"`
+	AggregateTimer       int       `json:"aggregateTimer,omitempty"`
+	BgpTimers            bgpTimers `json:"bgpTimers"`
+	LogNeighborChanges   bool      `json:"logNeighborChanges"`
+	FastExternalFallOver bool      `json:"fastExternalFallOver"`
+	Type                 string    `json:"type"` //bgpgeneralsettings
+}
+
+type bgpTimers struct {
+	KeepAlive   int    `json:"keepAlive"`
+	HoldTime    int    `json:"holdTime"`
+	MinHoldTime int    `json:"minHoldTime"`
+	Type        string `json:"type"` //bgptimers
+}
+
+type bgp struct {
+	ID                string               `json:"id,omitempty"`
+	Version           string               `json:"version,omitempty"`
+	Name              string               `json:"name"`
+	AsNumber          string               `json:"asNumber"`
+	RouterId          string               `json:"routerId,omitempty"`
+	AddressFamilyIPv4 bgpAddressFamilyIPv4 `json:"addressFamilyIPv4"`
+	Type              string               `json:"type"` //bgp
+}
+
+type bgpAddressFamilyIPv4 struct {
+	Networks  []bgpNetwork  `json:"networks"`
+	Neighbors []bgpNeighbor `json:"neighbors"`
+	Type      string        `json:"type"` //afipv4
+}
+
+type bgpNetwork struct {
+	Ipv4Address ftdc.ReferenceModel  `json:"ipv4Address"`
+	RouteMap    *ftdc.ReferenceModel `json:"routeMap,omitempty"`
+	Type        string               `json:"type"` //afipv4network
+}
+
+type bgpNeighbor struct {
+	Ipv4Address      string              `json:"ipv4Address"`
+	RemoteAs         string              `json:"remoteAs"`
+	Activate         bool                `json:"activate"`
+	NeighborGeneral  bgpNeighborGeneral  `json:"neighborGeneral"`
+	NeighborAdvanced bgpNeighborAdvanced `json:"neighborAdvanced"`
+	NeighborTimers   bgpNeighborTimers   `json:"neighborTimers"`
+	NeighborRoutes   bgpNeighborRoutes   `json:"neighborRoutes"`
+	Type             string              `json:"type"` //neighboripv4
+}
+
+type bgpNeighborGeneral struct {
+	Description string `json:"description,omitempty"`
+	Shutdown    bool   `json:"shutdown"`
+	Type        string `json:"type"` //neighborgeneral
+}
+
+// bgpNeighborAdvanced - NeighborSecret is never returned by FDM
+type bgpNeighborAdvanced struct {
+	NeighborSecret string `json:"neighborSecret,omitempty"`
+	Type           string `json:"type"` //neighboradvanced
+}
+
+type bgpNeighborTimers struct {
+	KeepAliveInterval int    `json:"keepAliveInterval,omitempty"`
+	HoldTime          int    `json:"holdTime,omitempty"`
+	MinimumHoldTime   int    `json:"minimumHoldTime,omitempty"`
+	Type              string `json:"type"` //neighbortimers
+}
+
+type bgpNeighborRoutes struct {
+	IncomingRouteMap   *ftdc.ReferenceModel `json:"incomingRouteMap,omitempty"`
+	OutgoingRouteMap   *ftdc.ReferenceModel `json:"outgoingRouteMap,omitempty"`
+	IncomingPrefixList *ftdc.ReferenceModel `json:"incomingPrefixList,omitempty"`
+	OutgoingPrefixList *ftdc.ReferenceModel `json:"outgoingPrefixList,omitempty"`
+	Type               string               `json:"type"` //neighborroutes
+}
+
+func getBGPGeneralSettings(c *ftdc.Client, ID string) (*bgpGeneralSettings, error) {
+	s := bgpGeneralSettings{}
+	err := doRequest(c, &s, fmt.Sprintf("devices/default/routing/bgpgeneralsettings/%s", ID), http.MethodGet)
+	return &s, err
+}
+
+// listBGPGeneralSettings returns the existing settings or nil if BGP was never configured
+func listBGPGeneralSettings(c *ftdc.Client) (*bgpGeneralSettings, error) {
+	settings := listItems[bgpGeneralSettings]{}
+	err := doRequest(c, &settings, "devices/default/routing/bgpgeneralsettings", http.MethodGet)
+	if err != nil || len(settings.Items) == 0 {
+		return nil, err
+	}
+	return &settings.Items[0], nil
+}
+
+func createBGPGeneralSettings(c *ftdc.Client, s bgpGeneralSettings) (*bgpGeneralSettings, error) {
+	err := doRequest(c, &s, "devices/default/routing/bgpgeneralsettings", http.MethodPost)
+	return &s, err
+}
+
+func updateBGPGeneralSettings(c *ftdc.Client, s bgpGeneralSettings) (*bgpGeneralSettings, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devices/default/routing/bgpgeneralsettings/%s", s.ID), http.MethodPut)
+	return &s, err
+}
+
+func deleteBGPGeneralSettings(c *ftdc.Client, s bgpGeneralSettings) error {
+	return doRequest(c, &s, fmt.Sprintf("devices/default/routing/bgpgeneralsettings/%s", s.ID), http.MethodDelete)
+}
+
+func getBGP(c *ftdc.Client, vrId string, ID string) (*bgp, error) {
+	b := bgp{}
+	err := doRequest(c, &b, fmt.Sprintf("devices/default/routing/virtualrouters/%s/bgp/%s", vrId, ID), http.MethodGet)
+	return &b, err
+}
+
+func createBGP(c *ftdc.Client, vrId string, b bgp) (*bgp, error) {
+	err := doRequest(c, &b, fmt.Sprintf("devices/default/routing/virtualrouters/%s/bgp", vrId), http.MethodPost)
+	return &b, err
+}
+
+func updateBGP(c *ftdc.Client, vrId string, b bgp) (*bgp, error) {
+	err := doRequest(c, &b, fmt.Sprintf("devices/default/routing/virtualrouters/%s/bgp/%s", vrId, b.ID), http.MethodPut)
+	return &b, err
+}
+
+func deleteBGP(c *ftdc.Client, vrId string, b bgp) error {
+	return doRequest(c, &b, fmt.Sprintf("devices/default/routing/virtualrouters/%s/bgp/%s", vrId, b.ID), http.MethodDelete)
+}
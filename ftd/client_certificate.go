@@ -0,0 +1,93 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// certificate - internalcertificate, internalcacertificate or externalcacertificate
+type certificate struct {
+	ID                string `json:"id,omitempty"`
+	Version           string `json:"version,omitempty"`
+	Name              string `json:"name"`
+	Cert              string `json:"cert,omitempty"`       //PEM
+	PrivateKey        string `json:"privateKey,omitempty"` //PEM, never returned
+	CertType          string `json:"certType,omitempty"`   //['UPLOAD', 'SELFSIGNED']
+	IssuerCommonName  string `json:"issuerCommonName,omitempty"`
+	SubjectCommonName string `json:"subjectCommonName,omitempty"`
+	ValidityStartDate string `json:"validityStartDate,omitempty"`
+	ValidityEndDate   string `json:"validityEndDate,omitempty"`
+	IsSystemDefined   bool   `json:"isSystemDefined,omitempty"`
+	Type              string `json:"type"`
+}
+
+func certificatePath(certificateType string) (string, error) {
+	switch certificateType {
+	case "internalcertificate":
+		return "object/internalcertificates", nil
+	case "internalcacertificate":
+		return "object/internalcacertificates", nil
+	case "externalcacertificate":
+		return "object/externalcacertificates", nil
+	default:
+		return "", fmt.Errorf("expect internalcertificate, internalcacertificate or externalcacertificate, got: %s", certificateType)
+	}
+}
+
+func getCertificate(c *ftdc.Client, ID string, certificateType string) (*certificate, error) {
+	cert := certificate{}
+	path, err := certificatePath(certificateType)
+	if err != nil {
+		return &cert, err
+	}
+	err = doRequest(c, &cert, fmt.Sprintf("%s/%s", path, ID), http.MethodGet)
+	return &cert, err
+}
+
+func getCertificateByName(c *ftdc.Client, name string, certificateType string) (*certificate, error) {
+	path, err := certificatePath(certificateType)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := listItems[certificate]{}
+	err = doRequest(c, &certs, fmt.Sprintf("%s?filter=name:%s", path, url.QueryEscape(name)), http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range certs.Items {
+		if cert.Name == name {
+			return &cert, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s named %s", certificateType, name)
+}
+
+func createCertificate(c *ftdc.Client, cert certificate) (*certificate, error) {
+	path, err := certificatePath(cert.Type)
+	if err != nil {
+		return &cert, err
+	}
+	err = doRequest(c, &cert, path, http.MethodPost)
+	return &cert, err
+}
+
+func updateCertificate(c *ftdc.Client, cert certificate) (*certificate, error) {
+	path, err := certificatePath(cert.Type)
+	if err != nil {
+		return &cert, err
+	}
+	err = doRequest(c, &cert, fmt.Sprintf("%s/%s", path, cert.ID), http.MethodPut)
+	return &cert, err
+}
+
+func deleteCertificate(c *ftdc.Client, cert certificate) error {
+	path, err := certificatePath(cert.Type)
+	if err != nil {
+		return err
+	}
+	return doRequest(c, &cert, fmt.Sprintf("%s/%s", path, cert.ID), http.MethodDelete)
+}
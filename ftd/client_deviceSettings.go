@@ -0,0 +1,94 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type deviceHostname struct {
+	ID       string `json:"id,omitempty"`
+	Version  string `json:"version,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Hostname string `json:"hostname"`
+	Type     string `json:"type"` //devicehostname
+}
+
+type bannerSettings struct {
+	ID          string `json:"id,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Name        string `json:"name,omitempty"`
+	LoginBanner string `json:"loginBanner"`
+	MotdBanner  string `json:"motdBanner"`
+	Type        string `json:"type"` //bannersettings
+}
+
+type consoleTimeout struct {
+	ID             string `json:"id,omitempty"`
+	Version        string `json:"version,omitempty"`
+	Name           string `json:"name,omitempty"`
+	ConsoleTimeout int    `json:"consoleTimeout"` //minutes, 0 never times out
+	Type           string `json:"type"`           //consoletimeout
+}
+
+type webAnalyticsSettings struct {
+	ID                 string `json:"id,omitempty"`
+	Version            string `json:"version,omitempty"`
+	Name               string `json:"name,omitempty"`
+	EnableWebAnalytics bool   `json:"enableWebAnalytics"`
+	Type               string `json:"type"` //webanalyticssettings
+}
+
+type successNetworkSettings struct {
+	ID                   string `json:"id,omitempty"`
+	Version              string `json:"version,omitempty"`
+	Name                 string `json:"name,omitempty"`
+	EnableSuccessNetwork bool   `json:"enableSuccessNetwork"`
+	Type                 string `json:"type"` //successnetworksettings
+}
+
+func getDeviceHostname(c *ftdc.Client) (*deviceHostname, error) {
+	return getSingleton[deviceHostname](c, "devicesettings/default/devicehostnames")
+}
+
+func updateDeviceHostname(c *ftdc.Client, h deviceHostname) (*deviceHostname, error) {
+	err := doRequest(c, &h, fmt.Sprintf("devicesettings/default/devicehostnames/%s", h.ID), http.MethodPut)
+	return &h, err
+}
+
+func getBannerSettings(c *ftdc.Client) (*bannerSettings, error) {
+	return getSingleton[bannerSettings](c, "devicesettings/default/banners")
+}
+
+func updateBannerSettings(c *ftdc.Client, b bannerSettings) (*bannerSettings, error) {
+	err := doRequest(c, &b, fmt.Sprintf("devicesettings/default/banners/%s", b.ID), http.MethodPut)
+	return &b, err
+}
+
+func getConsoleTimeout(c *ftdc.Client) (*consoleTimeout, error) {
+	return getSingleton[consoleTimeout](c, "devicesettings/default/consoletimeouts")
+}
+
+func updateConsoleTimeout(c *ftdc.Client, t consoleTimeout) (*consoleTimeout, error) {
+	err := doRequest(c, &t, fmt.Sprintf("devicesettings/default/consoletimeouts/%s", t.ID), http.MethodPut)
+	return &t, err
+}
+
+func getWebAnalyticsSettings(c *ftdc.Client) (*webAnalyticsSettings, error) {
+	return getSingleton[webAnalyticsSettings](c, "devicesettings/default/webanalyticssettings")
+}
+
+func updateWebAnalyticsSettings(c *ftdc.Client, w webAnalyticsSettings) (*webAnalyticsSettings, error) {
+	err := doRequest(c, &w, fmt.Sprintf("devicesettings/default/webanalyticssettings/%s", w.ID), http.MethodPut)
+	return &w, err
+}
+
+func getSuccessNetworkSettings(c *ftdc.Client) (*successNetworkSettings, error) {
+	return getSingleton[successNetworkSettings](c, "devicesettings/default/successnetworksettings")
+}
+
+func updateSuccessNetworkSettings(c *ftdc.Client, s successNetworkSettings) (*successNetworkSettings, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devicesettings/default/successnetworksettings/%s", s.ID), http.MethodPut)
+	return &s, err
+}
@@ -0,0 +1,39 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// dhcpServerContainer - device wide DHCP server settings with per interface pools
+type dhcpServerContainer struct {
+	ID            string               `json:"id,omitempty"`
+	Version       string               `json:"version,omitempty"`
+	Name          string               `json:"name,omitempty"`
+	AutoConfig    bool                 `json:"autoConfig"`
+	PrimaryDNS    string               `json:"primaryDNS,omitempty"`
+	SecondaryDNS  string               `json:"secondaryDNS,omitempty"`
+	PrimaryWINS   string               `json:"primaryWINS,omitempty"`
+	SecondaryWINS string               `json:"secondaryWINS,omitempty"`
+	Interface     *ftdc.ReferenceModel `json:"interface,omitempty"` //interface auto configuration is taken from
+	Servers       []dhcpServer         `json:"servers"`
+	Type          string               `json:"type"` //dhcpservercontainer
+}
+
+type dhcpServer struct {
+	EnableDHCP  bool                `json:"enableDHCP"`
+	AddressPool string              `json:"addressPool"` //192.168.45.10-192.168.45.100
+	Interface   ftdc.ReferenceModel `json:"interface"`
+	Type        string              `json:"type"` //dhcpserver
+}
+
+func getDHCPServerContainer(c *ftdc.Client) (*dhcpServerContainer, error) {
+	return getSingleton[dhcpServerContainer](c, "devicesettings/default/dhcpservercontainers")
+}
+
+func updateDHCPServerContainer(c *ftdc.Client, s dhcpServerContainer) (*dhcpServerContainer, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devicesettings/default/dhcpservercontainers/%s", s.ID), http.MethodPut)
+	return &s, err
+}
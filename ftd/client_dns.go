@@ -0,0 +1,89 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type dnsServerGroup struct {
+	ID            string      `json:"id,omitempty"`
+	Version       string      `json:"version,omitempty"`
+	Name          string      `json:"name"`
+	DnsServers    []dnsServer `json:"dnsServers"`
+	SearchDomain  string      `json:"searchDomain,omitempty"`
+	Timeout       int         `json:"timeout,omitempty"`
+	Retries       int         `json:"retries,omitempty"`
+	SystemDefined bool        `json:"systemDefined,omitempty"`
+	Type          string      `json:"type"` //dnsservergroup
+}
+
+type dnsServer struct {
+	IpAddress string `json:"ipAddress"`
+	Type      string `json:"type"` //dnsserver
+}
+
+// deviceDNSSettings - DNS used by the management interface
+type deviceDNSSettings struct {
+	ID             string               `json:"id,omitempty"`
+	Version        string               `json:"version,omitempty"`
+	Name           string               `json:"name,omitempty"`
+	DnsServerGroup *ftdc.ReferenceModel `json:"dnsServerGroup,omitempty"`
+	Type           string               `json:"type"` //devicednssettings
+}
+
+// dataDNSSettings - DNS used by data interfaces, required to resolve FQDN network objects
+type dataDNSSettings struct {
+	ID              string                `json:"id,omitempty"`
+	Version         string                `json:"version,omitempty"`
+	Name            string                `json:"name,omitempty"`
+	DnsServerGroup  *ftdc.ReferenceModel  `json:"dnsServerGroup,omitempty"`
+	Interfaces      []ftdc.ReferenceModel `json:"interfaces"`
+	FqdnDNSSettings *fqdnDNSSettings      `json:"fqdnDNSSettings,omitempty"`
+	Type            string                `json:"type"` //datadnssettings
+}
+
+type fqdnDNSSettings struct {
+	PollTimer        int    `json:"pollTimer,omitempty"`
+	ExpiryEntryTimer int    `json:"expiryEntryTimer,omitempty"`
+	Type             string `json:"type"` //fqdndnssettings
+}
+
+func getDNSServerGroup(c *ftdc.Client, ID string) (*dnsServerGroup, error) {
+	g := dnsServerGroup{}
+	err := doRequest(c, &g, fmt.Sprintf("object/dnsservergroups/%s", ID), http.MethodGet)
+	return &g, err
+}
+
+func createDNSServerGroup(c *ftdc.Client, g dnsServerGroup) (*dnsServerGroup, error) {
+	err := doRequest(c, &g, "object/dnsservergroups", http.MethodPost)
+	return &g, err
+}
+
+func updateDNSServerGroup(c *ftdc.Client, g dnsServerGroup) (*dnsServerGroup, error) {
+	err := doRequest(c, &g, fmt.Sprintf("object/dnsservergroups/%s", g.ID), http.MethodPut)
+	return &g, err
+}
+
+func deleteDNSServerGroup(c *ftdc.Client, g dnsServerGroup) error {
+	return doRequest(c, &g, fmt.Sprintf("object/dnsservergroups/%s", g.ID), http.MethodDelete)
+}
+
+func getDeviceDNSSettings(c *ftdc.Client) (*deviceDNSSettings, error) {
+	return getSingleton[deviceDNSSettings](c, "devicesettings/default/devicednssettings")
+}
+
+func updateDeviceDNSSettings(c *ftdc.Client, s deviceDNSSettings) (*deviceDNSSettings, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devicesettings/default/devicednssettings/%s", s.ID), http.MethodPut)
+	return &s, err
+}
+
+func getDataDNSSettings(c *ftdc.Client) (*dataDNSSettings, error) {
+	return getSingleton[dataDNSSettings](c, "devicesettings/default/datadnssettings")
+}
+
+func updateDataDNSSettings(c *ftdc.Client, s dataDNSSettings) (*dataDNSSettings, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devicesettings/default/datadnssettings/%s", s.ID), http.MethodPut)
+	return &s, err
+}
@@ -0,0 +1,103 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type haConfiguration struct {
+	ID                            string               `json:"id,omitempty"`
+	Version                       string               `json:"version,omitempty"`
+	Name                          string               `json:"name,omitempty"`
+	NodeRole                      string               `json:"nodeRole"` //['HA_PRIMARY', 'HA_SECONDARY']
+	FailoverInterface             *ftdc.ReferenceModel `json:"failoverInterface,omitempty"`
+	FailoverName                  string               `json:"failoverName,omitempty"`
+	PrimaryFailoverIPv4           *haIPv4Address       `json:"primaryFailoverIPv4,omitempty"`
+	SecondaryFailoverIPv4         *haIPv4Address       `json:"secondaryFailoverIPv4,omitempty"`
+	StatefulFailoverInterface     *ftdc.ReferenceModel `json:"statefulFailoverInterface,omitempty"`
+	StatefulFailoverName          string               `json:"statefulFailoverName,omitempty"`
+	PrimaryStatefulFailoverIPv4   *haIPv4Address       `json:"primaryStatefulFailoverIPv4,omitempty"`
+	SecondaryStatefulFailoverIPv4 *haIPv4Address       `json:"secondaryStatefulFailoverIPv4,omitempty"`
+	SharedKey                     string               `json:"sharedKey,omitempty"`
+	Type                          string               `json:"type"` //haconfiguration
+}
+
+type haIPv4Address struct {
+	IpAddress string `json:"ipAddress"`
+	Netmask   string `json:"netmask"`
+	Type      string `json:"type"` //ipv4address
+}
+
+type haFailoverCriteria struct {
+	ID                        string `json:"id,omitempty"`
+	Version                   string `json:"version,omitempty"`
+	InterfaceFailureThreshold int    `json:"interfaceFailureThreshold"`
+	InterfaceFailureUnit      string `json:"interfaceFailureUnit"` //['PERCENTAGE', 'NUMBER']
+	PeerPollTime              int    `json:"peerPollTime"`
+	PeerPollTimeUnit          string `json:"peerPollTimeUnit"` //['SEC', 'MSEC']
+	PeerHoldTime              int    `json:"peerHoldTime"`
+	PeerHoldTimeUnit          string `json:"peerHoldTimeUnit"` //['SEC', 'MSEC']
+	InterfacePollTime         int    `json:"interfacePollTime"`
+	InterfacePollTimeUnit     string `json:"interfacePollTimeUnit"` //['SEC', 'MSEC']
+	InterfaceHoldTime         int    `json:"interfaceHoldTime"`
+	Type                      string `json:"type"` //hafailovercriteria
+}
+
+type haStatus struct {
+	ID             string `json:"id,omitempty"`
+	NodeState      string `json:"nodeState"`      //['SINGLE_NODE', 'HA_ACTIVE_NODE', 'HA_STANDBY_NODE', 'HA_FAILED_NODE', 'HA_CONFIGURATION_SYNC', 'HA_SUSPENDED_NODE', 'HA_UNKNOWN_NODE']
+	PeerNodeState  string `json:"peerNodeState"`  //same values as nodeState
+	ConfigStatus   string `json:"configStatus"`   //['IN_SYNC', 'OUT_OF_SYNC', 'UNKNOWN']
+	HaHealthStatus string `json:"haHealthStatus"` //['HEALTHY', 'UNHEALTHY', 'UNKNOWN']
+	DisabledReason string `json:"disabledReason,omitempty"`
+	Type           string `json:"type"` //hastatus
+}
+
+func getHAConfiguration(c *ftdc.Client) (*haConfiguration, error) {
+	return getSingleton[haConfiguration](c, "devices/default/ha/configurations")
+}
+
+func updateHAConfiguration(c *ftdc.Client, h haConfiguration) (*haConfiguration, error) {
+	err := doRequest(c, &h, fmt.Sprintf("devices/default/ha/configurations/%s", h.ID), http.MethodPut)
+	return &h, err
+}
+
+func getHAFailoverCriteria(c *ftdc.Client) (*haFailoverCriteria, error) {
+	return getSingleton[haFailoverCriteria](c, "devices/default/ha/failovercriteria")
+}
+
+func updateHAFailoverCriteria(c *ftdc.Client, f haFailoverCriteria) (*haFailoverCriteria, error) {
+	err := doRequest(c, &f, fmt.Sprintf("devices/default/ha/failovercriteria/%s", f.ID), http.MethodPut)
+	return &f, err
+}
+
+func getHAStatus(c *ftdc.Client) (*haStatus, error) {
+	s := haStatus{}
+	err := doRequest(c, &s, "devices/default/operational/ha/status/default", http.MethodGet)
+	return &s, err
+}
+
+// getMonitoredInterfaces returns physical interfaces with monitorInterface set, they are the ones HA health checks
+func getMonitoredInterfaces(c *ftdc.Client) ([]ftdc.ReferenceModel, error) {
+	interfaces := listItems[ftdc.NetworkInterface]{}
+	err := doRequest(c, &interfaces, "devices/default/interfaces?limit=1000", http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+
+	var monitored []ftdc.ReferenceModel
+	for _, iface := range interfaces.Items {
+		if !iface.MonitorInterface {
+			continue
+		}
+		monitored = append(monitored, ftdc.ReferenceModel{
+			ID:      iface.ID,
+			Version: iface.Version,
+			Name:    iface.Name,
+			Type:    iface.Type,
+		})
+	}
+	return monitored, nil
+}
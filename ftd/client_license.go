@@ -0,0 +1,87 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// licenseTypes are the optional feature licenses, BASE is always present
+var licenseTypes = []string{"THREAT", "MALWARE", "URLFILTERING", "PLUS", "APEX", "VPNONLY"}
+
+type smartAgentConnection struct {
+	ID             string `json:"id,omitempty"`
+	Version        string `json:"version,omitempty"`
+	ConnectionType string `json:"connectionType"` //['REGISTER', 'EVALUATION', 'UNREGISTER']
+	Token          string `json:"token,omitempty"`
+	Type           string `json:"type"` //smartagentconnection
+}
+
+type smartAgentStatus struct {
+	ID                        string `json:"id,omitempty"`
+	RegistrationStatus        string `json:"registrationStatus"`  //['UNREGISTERED', 'REGISTERED', 'EVALUATION', 'EVALUATION_EXPIRED', 'REGISTRATION_IN_PROGRESS', 'REGISTRATION_FAILED']
+	AuthorizationStatus       string `json:"authorizationStatus"` //['AUTHORIZED', 'OUT_OF_COMPLIANCE', 'AUTHORIZATION_EXPIRED', 'NOT_AUTHORIZED', 'UNKNOWN']
+	EvaluationPeriodRemaining int    `json:"evaluationPeriodRemaining,omitempty"`
+	Type                      string `json:"type"` //smartagentstatus
+}
+
+type license struct {
+	ID          string `json:"id,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Count       int    `json:"count"`
+	Compliant   bool   `json:"compliant,omitempty"`
+	LicenseType string `json:"licenseType"` //['BASE', 'THREAT', 'MALWARE', 'URLFILTERING', 'PLUS', 'APEX', 'VPNONLY']
+	Type        string `json:"type"`        //license
+}
+
+func getSmartAgentConnection(c *ftdc.Client) (*smartAgentConnection, error) {
+	connections := listItems[smartAgentConnection]{}
+	err := doRequest(c, &connections, "license/smartagentconnections", http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	if len(connections.Items) == 0 {
+		return nil, nil
+	}
+	return &connections.Items[0], nil
+}
+
+func createSmartAgentConnection(c *ftdc.Client, s smartAgentConnection) (*smartAgentConnection, error) {
+	err := doRequest(c, &s, "license/smartagentconnections", http.MethodPost)
+	return &s, err
+}
+
+func updateSmartAgentConnection(c *ftdc.Client, s smartAgentConnection) (*smartAgentConnection, error) {
+	err := doRequest(c, &s, fmt.Sprintf("license/smartagentconnections/%s", s.ID), http.MethodPut)
+	return &s, err
+}
+
+func deleteSmartAgentConnection(c *ftdc.Client, s smartAgentConnection) error {
+	return doRequest(c, &s, fmt.Sprintf("license/smartagentconnections/%s", s.ID), http.MethodDelete)
+}
+
+func getSmartAgentStatus(c *ftdc.Client) (*smartAgentStatus, error) {
+	return getSingleton[smartAgentStatus](c, "license/smartagentstatuses")
+}
+
+func getLicense(c *ftdc.Client, ID string) (*license, error) {
+	l := license{}
+	err := doRequest(c, &l, fmt.Sprintf("license/smartlicenses/%s", ID), http.MethodGet)
+	return &l, err
+}
+
+func getLicenses(c *ftdc.Client) ([]license, error) {
+	licenses := listItems[license]{}
+	err := doRequest(c, &licenses, "license/smartlicenses", http.MethodGet)
+	return licenses.Items, err
+}
+
+func createLicense(c *ftdc.Client, l license) (*license, error) {
+	err := doRequest(c, &l, "license/smartlicenses", http.MethodPost)
+	return &l, err
+}
+
+func deleteLicense(c *ftdc.Client, l license) error {
+	return doRequest(c, &l, fmt.Sprintf("license/smartlicenses/%s", l.ID), http.MethodDelete)
+}
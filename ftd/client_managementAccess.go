@@ -0,0 +1,39 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// managementAccess - networks allowed to reach FDM (HTTPS) or SSH on a data interface
+type managementAccess struct {
+	ID             string                `json:"id,omitempty"`
+	Version        string                `json:"version,omitempty"`
+	Name           string                `json:"name,omitempty"`
+	Interface      ftdc.ReferenceModel   `json:"interface"`
+	Protocols      []string              `json:"protocols"` //['HTTPS', 'SSH']
+	NetworkObjects []ftdc.ReferenceModel `json:"networkObjects"`
+	Type           string                `json:"type"` //managementaccess
+}
+
+func getManagementAccess(c *ftdc.Client, ID string) (*managementAccess, error) {
+	ma := managementAccess{}
+	err := doRequest(c, &ma, fmt.Sprintf("devicesettings/default/managementaccess/%s", ID), http.MethodGet)
+	return &ma, err
+}
+
+func createManagementAccess(c *ftdc.Client, ma managementAccess) (*managementAccess, error) {
+	err := doRequest(c, &ma, "devicesettings/default/managementaccess", http.MethodPost)
+	return &ma, err
+}
+
+func updateManagementAccess(c *ftdc.Client, ma managementAccess) (*managementAccess, error) {
+	err := doRequest(c, &ma, fmt.Sprintf("devicesettings/default/managementaccess/%s", ma.ID), http.MethodPut)
+	return &ma, err
+}
+
+func deleteManagementAccess(c *ftdc.Client, ma managementAccess) error {
+	return doRequest(c, &ma, fmt.Sprintf("devicesettings/default/managementaccess/%s", ma.ID), http.MethodDelete)
+}
@@ -0,0 +1,53 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type ntpSettings struct {
+	ID            string         `json:"id,omitempty"`
+	Version       string         `json:"version,omitempty"`
+	Name          string         `json:"name,omitempty"`
+	Enabled       bool           `json:"enabled"`
+	NtpServers    []string       `json:"ntpServers"`
+	NtpServerKeys []ntpServerKey `json:"ntpServerKeys,omitempty"`
+	Type          string         `json:"type"` //ntp
+}
+
+// ntpServerKey - symmetric key used to authenticate a NTP server. KeyValue is never returned by FDM
+type ntpServerKey struct {
+	Server   string `json:"server"`
+	KeyId    int    `json:"keyId"`
+	KeyType  string `json:"keyType,omitempty"` //['MD5', 'SHA1', 'SHA256']
+	KeyValue string `json:"keyValue,omitempty"`
+	Type     string `json:"type"` //ntpserverkey
+}
+
+type timeZoneSettings struct {
+	ID         string `json:"id,omitempty"`
+	Version    string `json:"version,omitempty"`
+	Name       string `json:"name,omitempty"`
+	TimeZoneId string `json:"timeZoneId,omitempty"`
+	Type       string `json:"type"` //timezonesettings
+}
+
+func getNTPSettings(c *ftdc.Client) (*ntpSettings, error) {
+	return getSingleton[ntpSettings](c, "devicesettings/default/ntp")
+}
+
+func updateNTPSettings(c *ftdc.Client, n ntpSettings) (*ntpSettings, error) {
+	err := doRequest(c, &n, fmt.Sprintf("devicesettings/default/ntp/%s", n.ID), http.MethodPut)
+	return &n, err
+}
+
+func getTimeZoneSettings(c *ftdc.Client) (*timeZoneSettings, error) {
+	return getSingleton[timeZoneSettings](c, "devicesettings/default/timezonesettings")
+}
+
+func updateTimeZoneSettings(c *ftdc.Client, t timeZoneSettings) (*timeZoneSettings, error) {
+	err := doRequest(c, &t, fmt.Sprintf("devicesettings/default/timezonesettings/%s", t.ID), http.MethodPut)
+	return &t, err
+}
@@ -0,0 +1,125 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type ospf struct {
+	ID                    string                     `json:"id,omitempty"`
+	Version               string                     `json:"version,omitempty"`
+	Name                  string                     `json:"name"`
+	ProcessId             string                     `json:"processId"`
+	Areas                 []ospfArea                 `json:"areas"`
+	RedistributeProtocols []ospfRedistributeProtocol `json:"redistributeProtocols"`
+	ProcessConfiguration  ospfProcessConfiguration   `json:"processConfiguration"`
+	Type                  string                     `json:"type"` //ospf
+}
+
+type ospfArea struct {
+	AreaId       string            `json:"areaId"`
+	AreaType     ospfAreaType      `json:"areaType"`
+	AreaNetworks []ospfAreaNetwork `json:"areaNetworks"`
+	Type         string            `json:"type"` //area
+}
+
+type ospfAreaType struct {
+	Type string `json:"type"` //['normalarea', 'stubarea', 'nssaarea']
+}
+
+type ospfAreaNetwork struct {
+	Ipv4Network ftdc.ReferenceModel `json:"ipv4Network"`
+	Type        string              `json:"type"` //areanetwork
+}
+
+type ospfRedistributeProtocol struct {
+	MetricValue     int                  `json:"metricValue,omitempty"`
+	MetricTypeValue string               `json:"metricTypeValue,omitempty"` //['TYPE_1', 'TYPE_2']
+	Subnets         bool                 `json:"subnets"`
+	AsNumber        string               `json:"asNumber,omitempty"`  //redistributebgp only
+	ProcessId       string               `json:"processId,omitempty"` //redistributeospf only
+	RouteMap        *ftdc.ReferenceModel `json:"routeMap,omitempty"`
+	Type            string               `json:"type"` //['redistributeconnected', 'redistributestatic', 'redistributebgp', 'redistributeospf']
+}
+
+type ospfProcessConfiguration struct {
+	RouterId                    string                           `json:"routerId,omitempty"`
+	DefaultInformationOriginate *ospfDefaultInformationOriginate `json:"defaultInformationOriginate,omitempty"`
+	Type                        string                           `json:"type"` //processconfiguration
+}
+
+type ospfDefaultInformationOriginate struct {
+	AlwaysAdvertise bool                 `json:"alwaysAdvertise"`
+	Metric          int                  `json:"metric,omitempty"`
+	MetricType      string               `json:"metricType,omitempty"` //['TYPE_1', 'TYPE_2']
+	RouteMap        *ftdc.ReferenceModel `json:"routeMap,omitempty"`
+	Type            string               `json:"type"` //defaultinformationoriginate
+}
+
+type ospfInterfaceSettings struct {
+	ID                                 string                             `json:"id,omitempty"`
+	Version                            string                             `json:"version,omitempty"`
+	Name                               string                             `json:"name,omitempty"`
+	DeviceInterface                    ftdc.ReferenceModel                `json:"deviceInterface"`
+	OspfInterfaceSettingsConfiguration ospfInterfaceSettingsConfiguration `json:"ospfInterfaceSettingsConfiguration"`
+	Type                               string                             `json:"type"` //ospfinterfacesettings
+}
+
+type ospfInterfaceSettingsConfiguration struct {
+	Cost               int                `json:"cost,omitempty"`
+	Priority           int                `json:"priority"`
+	HelloInterval      int                `json:"helloInterval,omitempty"`
+	DeadInterval       int                `json:"deadInterval,omitempty"`
+	OspfAuthentication ospfAuthentication `json:"ospfAuthentication"`
+	Type               string             `json:"type"` //ospfinterfacesettingsconfiguration
+}
+
+// ospfAuthentication - AuthKey is never returned by FDM
+type ospfAuthentication struct {
+	Authentication string `json:"authentication"` //['NONE', 'PASSWORD', 'MD5']
+	AuthKey        string `json:"authKey,omitempty"`
+	MD5KeyId       int    `json:"md5KeyId,omitempty"`
+	Type           string `json:"type"` //ospfauthentication
+}
+
+func getOSPF(c *ftdc.Client, vrId string, ID string) (*ospf, error) {
+	o := ospf{}
+	err := doRequest(c, &o, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospf/%s", vrId, ID), http.MethodGet)
+	return &o, err
+}
+
+func createOSPF(c *ftdc.Client, vrId string, o ospf) (*ospf, error) {
+	err := doRequest(c, &o, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospf", vrId), http.MethodPost)
+	return &o, err
+}
+
+func updateOSPF(c *ftdc.Client, vrId string, o ospf) (*ospf, error) {
+	err := doRequest(c, &o, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospf/%s", vrId, o.ID), http.MethodPut)
+	return &o, err
+}
+
+func deleteOSPF(c *ftdc.Client, vrId string, o ospf) error {
+	return doRequest(c, &o, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospf/%s", vrId, o.ID), http.MethodDelete)
+}
+
+func getOSPFInterfaceSettings(c *ftdc.Client, vrId string, ID string) (*ospfInterfaceSettings, error) {
+	s := ospfInterfaceSettings{}
+	err := doRequest(c, &s, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospfinterfacesettings/%s", vrId, ID), http.MethodGet)
+	return &s, err
+}
+
+func createOSPFInterfaceSettings(c *ftdc.Client, vrId string, s ospfInterfaceSettings) (*ospfInterfaceSettings, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospfinterfacesettings", vrId), http.MethodPost)
+	return &s, err
+}
+
+func updateOSPFInterfaceSettings(c *ftdc.Client, vrId string, s ospfInterfaceSettings) (*ospfInterfaceSettings, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospfinterfacesettings/%s", vrId, s.ID), http.MethodPut)
+	return &s, err
+}
+
+func deleteOSPFInterfaceSettings(c *ftdc.Client, vrId string, s ospfInterfaceSettings) error {
+	return doRequest(c, &s, fmt.Sprintf("devices/default/routing/virtualrouters/%s/ospfinterfacesettings/%s", vrId, s.ID), http.MethodDelete)
+}
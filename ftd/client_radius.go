@@ -0,0 +1,74 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type radiusServer struct {
+	ID                       string               `json:"id,omitempty"`
+	Version                  string               `json:"version,omitempty"`
+	Name                     string               `json:"name"`
+	Host                     string               `json:"host"`
+	Timeout                  int                  `json:"timeout,omitempty"`
+	ServerSecretKey          string               `json:"serverSecretKey,omitempty"`
+	ServerAuthenticationPort int                  `json:"serverAuthenticationPort,omitempty"`
+	ServerAccountingPort     int                  `json:"serverAccountingPort,omitempty"`
+	InterfaceForRadius       *ftdc.ReferenceModel `json:"interfaceForRadius,omitempty"`
+	Type                     string               `json:"type"` //radiusidentitysource
+}
+
+type radiusServerGroup struct {
+	ID                         string                `json:"id,omitempty"`
+	Version                    string                `json:"version,omitempty"`
+	Name                       string                `json:"name"`
+	Description                string                `json:"description,omitempty"`
+	DeadTime                   int                   `json:"deadTime"`
+	MaxFailedAttempts          int                   `json:"maxFailedAttempts"`
+	RadiusIdentitySources      []ftdc.ReferenceModel `json:"radiusIdentitySources"`
+	EnableDynamicAuthorization bool                  `json:"enableDynamicAuthorization"`
+	DynamicAuthorizationPort   int                   `json:"dynamicAuthorizationPort,omitempty"`
+	Type                       string                `json:"type"` //radiusidentitysourcegroup
+}
+
+func getRadiusServer(c *ftdc.Client, ID string) (*radiusServer, error) {
+	r := radiusServer{}
+	err := doRequest(c, &r, fmt.Sprintf("object/radiusidentitysources/%s", ID), http.MethodGet)
+	return &r, err
+}
+
+func createRadiusServer(c *ftdc.Client, r radiusServer) (*radiusServer, error) {
+	err := doRequest(c, &r, "object/radiusidentitysources", http.MethodPost)
+	return &r, err
+}
+
+func updateRadiusServer(c *ftdc.Client, r radiusServer) (*radiusServer, error) {
+	err := doRequest(c, &r, fmt.Sprintf("object/radiusidentitysources/%s", r.ID), http.MethodPut)
+	return &r, err
+}
+
+func deleteRadiusServer(c *ftdc.Client, r radiusServer) error {
+	return doRequest(c, &r, fmt.Sprintf("object/radiusidentitysources/%s", r.ID), http.MethodDelete)
+}
+
+func getRadiusServerGroup(c *ftdc.Client, ID string) (*radiusServerGroup, error) {
+	g := radiusServerGroup{}
+	err := doRequest(c, &g, fmt.Sprintf("object/radiusidentitysourcegroups/%s", ID), http.MethodGet)
+	return &g, err
+}
+
+func createRadiusServerGroup(c *ftdc.Client, g radiusServerGroup) (*radiusServerGroup, error) {
+	err := doRequest(c, &g, "object/radiusidentitysourcegroups", http.MethodPost)
+	return &g, err
+}
+
+func updateRadiusServerGroup(c *ftdc.Client, g radiusServerGroup) (*radiusServerGroup, error) {
+	err := doRequest(c, &g, fmt.Sprintf("object/radiusidentitysourcegroups/%s", g.ID), http.MethodPut)
+	return &g, err
+}
+
+func deleteRadiusServerGroup(c *ftdc.Client, g radiusServerGroup) error {
+	return doRequest(c, &g, fmt.Sprintf("object/radiusidentitysourcegroups/%s", g.ID), http.MethodDelete)
+}
@@ -0,0 +1,31 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// globalVirtualRouter - name of the virtual router routing processes are configured in by default
+const globalVirtualRouter = "Global"
+
+type virtualRouter struct {
+	ID      string `json:"id,omitempty"`
+	Version string `json:"version,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type"` //virtualrouter
+}
+
+func getVirtualRouterByName(c *ftdc.Client, name string) (*virtualRouter, error) {
+	routers := listItems[virtualRouter]{}
+	err := doRequest(c, &routers, fmt.Sprintf("devices/default/routing/virtualrouters?filter=name:%s", url.QueryEscape(name)), http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	if len(routers.Items) == 0 {
+		return nil, fmt.Errorf("virtual router %s not found", name)
+	}
+	return &routers.Items[0], nil
+}
@@ -0,0 +1,220 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type standardAccessList struct {
+	ID      string                    `json:"id,omitempty"`
+	Version string                    `json:"version,omitempty"`
+	Name    string                    `json:"name"`
+	Entries []standardAccessListEntry `json:"entries"`
+	Type    string                    `json:"type"` //standardaccesslist
+}
+
+type standardAccessListEntry struct {
+	Action   string                `json:"action"` //['PERMIT', 'DENY']
+	Networks []ftdc.ReferenceModel `json:"networks,omitempty"`
+	Type     string                `json:"type"` //standardaccesslistentry
+}
+
+type extendedAccessList struct {
+	ID      string                    `json:"id,omitempty"`
+	Version string                    `json:"version,omitempty"`
+	Name    string                    `json:"name"`
+	Entries []extendedAccessListEntry `json:"entries"`
+	Type    string                    `json:"type"` //extendedaccesslist
+}
+
+type extendedAccessListEntry struct {
+	Action              string                `json:"action"` //['PERMIT', 'DENY']
+	SourceNetworks      []ftdc.ReferenceModel `json:"sourceNetworks,omitempty"`
+	DestinationNetworks []ftdc.ReferenceModel `json:"destinationNetworks,omitempty"`
+	SourcePorts         []ftdc.ReferenceModel `json:"sourcePorts,omitempty"`
+	DestinationPorts    []ftdc.ReferenceModel `json:"destinationPorts,omitempty"`
+	Type                string                `json:"type"` //extendedaccesslistentry
+}
+
+// prefixList - ipv4prefixlist or ipv6prefixlist
+type prefixList struct {
+	ID      string            `json:"id,omitempty"`
+	Version string            `json:"version,omitempty"`
+	Name    string            `json:"name"`
+	Entries []prefixListEntry `json:"entries"`
+	Type    string            `json:"type"`
+}
+
+type prefixListEntry struct {
+	SequenceNumber  int    `json:"sequenceNumber"`
+	Action          string `json:"action"`    //['PERMIT', 'DENY']
+	IpAddress       string `json:"ipAddress"` //10.0.0.0/8
+	MinPrefixLength int    `json:"minPrefixLength,omitempty"`
+	MaxPrefixLength int    `json:"maxPrefixLength,omitempty"`
+	Type            string `json:"type"` //ipv4prefixlistentry or ipv6prefixlistentry
+}
+
+type asPathList struct {
+	ID      string            `json:"id,omitempty"`
+	Version string            `json:"version,omitempty"`
+	Name    string            `json:"name"`
+	Entries []asPathListEntry `json:"entries"`
+	Type    string            `json:"type"` //aspathlist
+}
+
+type asPathListEntry struct {
+	Action            string `json:"action"` //['PERMIT', 'DENY']
+	RegularExpression string `json:"regularExpression"`
+	Type              string `json:"type"` //aspathlistentry
+}
+
+type routeMap struct {
+	ID      string          `json:"id,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Name    string          `json:"name"`
+	Entries []routeMapEntry `json:"entries"`
+	Type    string          `json:"type"` //routemap
+}
+
+type routeMapEntry struct {
+	SequenceNumber          int                   `json:"sequenceNumber"`
+	Action                  string                `json:"action"` //['PERMIT', 'DENY']
+	IPv4AccessListAddresses []ftdc.ReferenceModel `json:"ipv4AccessListAddresses,omitempty"`
+	IPv4PrefixListAddresses []ftdc.ReferenceModel `json:"ipv4PrefixListAddresses,omitempty"`
+	AsPathAccessLists       []ftdc.ReferenceModel `json:"asPathAccessLists,omitempty"`
+	Interfaces              []ftdc.ReferenceModel `json:"interfaces,omitempty"`
+	MetricValue             int                   `json:"metricValue,omitempty"`
+	LocalPreference         int                   `json:"localPreference,omitempty"`
+	Weight                  int                   `json:"weight,omitempty"`
+	PrependASPath           []string              `json:"prependASPath,omitempty"`
+	Type                    string                `json:"type"` //routemapentry
+}
+
+func getStandardAccessList(c *ftdc.Client, ID string) (*standardAccessList, error) {
+	l := standardAccessList{}
+	err := doRequest(c, &l, fmt.Sprintf("object/standardaccesslists/%s", ID), http.MethodGet)
+	return &l, err
+}
+
+func createStandardAccessList(c *ftdc.Client, l standardAccessList) (*standardAccessList, error) {
+	err := doRequest(c, &l, "object/standardaccesslists", http.MethodPost)
+	return &l, err
+}
+
+func updateStandardAccessList(c *ftdc.Client, l standardAccessList) (*standardAccessList, error) {
+	err := doRequest(c, &l, fmt.Sprintf("object/standardaccesslists/%s", l.ID), http.MethodPut)
+	return &l, err
+}
+
+func deleteStandardAccessList(c *ftdc.Client, l standardAccessList) error {
+	return doRequest(c, &l, fmt.Sprintf("object/standardaccesslists/%s", l.ID), http.MethodDelete)
+}
+
+func getExtendedAccessList(c *ftdc.Client, ID string) (*extendedAccessList, error) {
+	l := extendedAccessList{}
+	err := doRequest(c, &l, fmt.Sprintf("object/extendedaccesslists/%s", ID), http.MethodGet)
+	return &l, err
+}
+
+func createExtendedAccessList(c *ftdc.Client, l extendedAccessList) (*extendedAccessList, error) {
+	err := doRequest(c, &l, "object/extendedaccesslists", http.MethodPost)
+	return &l, err
+}
+
+func updateExtendedAccessList(c *ftdc.Client, l extendedAccessList) (*extendedAccessList, error) {
+	err := doRequest(c, &l, fmt.Sprintf("object/extendedaccesslists/%s", l.ID), http.MethodPut)
+	return &l, err
+}
+
+func deleteExtendedAccessList(c *ftdc.Client, l extendedAccessList) error {
+	return doRequest(c, &l, fmt.Sprintf("object/extendedaccesslists/%s", l.ID), http.MethodDelete)
+}
+
+func prefixListPath(prefixListType string) (string, error) {
+	switch prefixListType {
+	case "ipv4prefixlist":
+		return "object/ipv4prefixlists", nil
+	case "ipv6prefixlist":
+		return "object/ipv6prefixlists", nil
+	default:
+		return "", fmt.Errorf("expect ipv4prefixlist or ipv6prefixlist, got: %s", prefixListType)
+	}
+}
+
+func getPrefixList(c *ftdc.Client, ID string, prefixListType string) (*prefixList, error) {
+	l := prefixList{}
+	path, err := prefixListPath(prefixListType)
+	if err != nil {
+		return &l, err
+	}
+	err = doRequest(c, &l, fmt.Sprintf("%s/%s", path, ID), http.MethodGet)
+	return &l, err
+}
+
+func createPrefixList(c *ftdc.Client, l prefixList) (*prefixList, error) {
+	path, err := prefixListPath(l.Type)
+	if err != nil {
+		return &l, err
+	}
+	err = doRequest(c, &l, path, http.MethodPost)
+	return &l, err
+}
+
+func updatePrefixList(c *ftdc.Client, l prefixList) (*prefixList, error) {
+	path, err := prefixListPath(l.Type)
+	if err != nil {
+		return &l, err
+	}
+	err = doRequest(c, &l, fmt.Sprintf("%s/%s", path, l.ID), http.MethodPut)
+	return &l, err
+}
+
+func deletePrefixList(c *ftdc.Client, l prefixList) error {
+	path, err := prefixListPath(l.Type)
+	if err != nil {
+		return err
+	}
+	return doRequest(c, &l, fmt.Sprintf("%s/%s", path, l.ID), http.MethodDelete)
+}
+
+func getASPathList(c *ftdc.Client, ID string) (*asPathList, error) {
+	l := asPathList{}
+	err := doRequest(c, &l, fmt.Sprintf("object/aspathlists/%s", ID), http.MethodGet)
+	return &l, err
+}
+
+func createASPathList(c *ftdc.Client, l asPathList) (*asPathList, error) {
+	err := doRequest(c, &l, "object/aspathlists", http.MethodPost)
+	return &l, err
+}
+
+func updateASPathList(c *ftdc.Client, l asPathList) (*asPathList, error) {
+	err := doRequest(c, &l, fmt.Sprintf("object/aspathlists/%s", l.ID), http.MethodPut)
+	return &l, err
+}
+
+func deleteASPathList(c *ftdc.Client, l asPathList) error {
+	return doRequest(c, &l, fmt.Sprintf("object/aspathlists/%s", l.ID), http.MethodDelete)
+}
+
+func getRouteMap(c *ftdc.Client, ID string) (*routeMap, error) {
+	r := routeMap{}
+	err := doRequest(c, &r, fmt.Sprintf("object/routemaps/%s", ID), http.MethodGet)
+	return &r, err
+}
+
+func createRouteMap(c *ftdc.Client, r routeMap) (*routeMap, error) {
+	err := doRequest(c, &r, "object/routemaps", http.MethodPost)
+	return &r, err
+}
+
+func updateRouteMap(c *ftdc.Client, r routeMap) (*routeMap, error) {
+	err := doRequest(c, &r, fmt.Sprintf("object/routemaps/%s", r.ID), http.MethodPut)
+	return &r, err
+}
+
+func deleteRouteMap(c *ftdc.Client, r routeMap) error {
+	return doRequest(c, &r, fmt.Sprintf("object/routemaps/%s", r.ID), http.MethodDelete)
+}
@@ -0,0 +1,107 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// snmpServer - device wide SNMP agent settings
+type snmpServer struct {
+	ID       string `json:"id,omitempty"`
+	Version  string `json:"version,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Location string `json:"location,omitempty"`
+	Contact  string `json:"contact,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Type     string `json:"type"` //snmpserver
+}
+
+// snmpUser - SNMPv3 user. Passwords are never returned by FDM
+type snmpUser struct {
+	ID                      string `json:"id,omitempty"`
+	Version                 string `json:"version,omitempty"`
+	Name                    string `json:"name"`
+	SecurityLevel           string `json:"securityLevel"`                     //['AUTH', 'NOAUTH', 'PRIV']
+	AuthenticationAlgorithm string `json:"authenticationAlgorithm,omitempty"` //['SHA', 'SHA256']
+	AuthenticationPassword  string `json:"authenticationPassword,omitempty"`
+	EncryptionAlgorithm     string `json:"encryptionAlgorithm,omitempty"` //['AES128', 'AES192', 'AES256', '3DES']
+	EncryptionPassword      string `json:"encryptionPassword,omitempty"`
+	Type                    string `json:"type"` //snmpuser
+}
+
+type snmpHost struct {
+	ID                    string                    `json:"id,omitempty"`
+	Version               string                    `json:"version,omitempty"`
+	Name                  string                    `json:"name"`
+	ManagerAddress        ftdc.ReferenceModel       `json:"managerAddress"`
+	Interface             ftdc.ReferenceModel       `json:"interface"`
+	UdpPort               int                       `json:"udpPort,omitempty"`
+	PollEnabled           bool                      `json:"pollEnabled"`
+	TrapEnabled           bool                      `json:"trapEnabled"`
+	SecurityConfiguration snmpSecurityConfiguration `json:"securityConfiguration"`
+	Type                  string                    `json:"type"` //snmphost
+}
+
+// snmpSecurityConfiguration - community for snmpv2csecurityconfiguration, authentication for snmpv3securityconfiguration
+type snmpSecurityConfiguration struct {
+	Community      string               `json:"community,omitempty"`
+	Authentication *ftdc.ReferenceModel `json:"authentication,omitempty"`
+	Type           string               `json:"type"`
+}
+
+func getSNMPServer(c *ftdc.Client) (*snmpServer, error) {
+	return getSingleton[snmpServer](c, "devicesettings/default/snmpservers")
+}
+
+func updateSNMPServer(c *ftdc.Client, s snmpServer) (*snmpServer, error) {
+	err := doRequest(c, &s, fmt.Sprintf("devicesettings/default/snmpservers/%s", s.ID), http.MethodPut)
+	return &s, err
+}
+
+func getSNMPUser(c *ftdc.Client, ID string) (*snmpUser, error) {
+	u := snmpUser{}
+	err := doRequest(c, &u, fmt.Sprintf("object/snmpusers/%s", ID), http.MethodGet)
+	return &u, err
+}
+
+func getSNMPUsers(c *ftdc.Client) ([]snmpUser, error) {
+	users := listItems[snmpUser]{}
+	err := doRequest(c, &users, "object/snmpusers?limit=1000", http.MethodGet)
+	return users.Items, err
+}
+
+func createSNMPUser(c *ftdc.Client, u snmpUser) (*snmpUser, error) {
+	err := doRequest(c, &u, "object/snmpusers", http.MethodPost)
+	return &u, err
+}
+
+func updateSNMPUser(c *ftdc.Client, u snmpUser) (*snmpUser, error) {
+	err := doRequest(c, &u, fmt.Sprintf("object/snmpusers/%s", u.ID), http.MethodPut)
+	return &u, err
+}
+
+func deleteSNMPUser(c *ftdc.Client, u snmpUser) error {
+	return doRequest(c, &u, fmt.Sprintf("object/snmpusers/%s", u.ID), http.MethodDelete)
+}
+
+func getSNMPHost(c *ftdc.Client, ID string) (*snmpHost, error) {
+	h := snmpHost{}
+	err := doRequest(c, &h, fmt.Sprintf("object/snmphosts/%s", ID), http.MethodGet)
+	return &h, err
+}
+
+func createSNMPHost(c *ftdc.Client, h snmpHost) (*snmpHost, error) {
+	err := doRequest(c, &h, "object/snmphosts", http.MethodPost)
+	return &h, err
+}
+
+func updateSNMPHost(c *ftdc.Client, h snmpHost) (*snmpHost, error) {
+	err := doRequest(c, &h, fmt.Sprintf("object/snmphosts/%s", h.ID), http.MethodPut)
+	return &h, err
+}
+
+func deleteSNMPHost(c *ftdc.Client, h snmpHost) error {
+	return doRequest(c, &h, fmt.Sprintf("object/snmphosts/%s", h.ID), http.MethodDelete)
+}
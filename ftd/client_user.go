@@ -0,0 +1,78 @@
+package ftd
+
+import (
+	"fmt"
+	"net/http"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+type localUser struct {
+	ID               string   `json:"id,omitempty"`
+	Version          string   `json:"version,omitempty"`
+	Name             string   `json:"name"`
+	Password         string   `json:"password,omitempty"`
+	UserRole         string   `json:"userRole,omitempty"`         //['ROLE_ADMIN', 'ROLE_READ_WRITE', 'ROLE_READ_ONLY']
+	UserServiceTypes []string `json:"userServiceTypes,omitempty"` //['MCV', 'RA_VPN']
+	Type             string   `json:"type"`                       //user
+}
+
+type aaaSetting struct {
+	ID           string               `json:"id,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	ProtocolType string               `json:"protocolType"` //['HTTPS', 'SSH']
+	AuthGroup    *ftdc.ReferenceModel `json:"authGroup,omitempty"`
+	UseLocal     string               `json:"useLocal,omitempty"` //['BEFORE_EXTERNAL', 'AFTER_EXTERNAL', 'NEVER']
+	Type         string               `json:"type"`               //aaasetting
+}
+
+func getLocalUser(c *ftdc.Client, ID string) (*localUser, error) {
+	u := localUser{}
+	err := doRequest(c, &u, fmt.Sprintf("object/users/%s", ID), http.MethodGet)
+	return &u, err
+}
+
+func createLocalUser(c *ftdc.Client, u localUser) (*localUser, error) {
+	err := doRequest(c, &u, "object/users", http.MethodPost)
+	return &u, err
+}
+
+func updateLocalUser(c *ftdc.Client, u localUser) (*localUser, error) {
+	err := doRequest(c, &u, fmt.Sprintf("object/users/%s", u.ID), http.MethodPut)
+	return &u, err
+}
+
+func deleteLocalUser(c *ftdc.Client, u localUser) error {
+	return doRequest(c, &u, fmt.Sprintf("object/users/%s", u.ID), http.MethodDelete)
+}
+
+func getAAASetting(c *ftdc.Client, ID string) (*aaaSetting, error) {
+	a := aaaSetting{}
+	err := doRequest(c, &a, fmt.Sprintf("devicesettings/default/aaasettings/%s", ID), http.MethodGet)
+	return &a, err
+}
+
+// getAAASettingByProtocol returns the management authentication settings of HTTPS or SSH
+func getAAASettingByProtocol(c *ftdc.Client, protocol string) (*aaaSetting, error) {
+	settings := listItems[aaaSetting]{}
+	err := doRequest(c, &settings, "devicesettings/default/aaasettings", http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	for _, setting := range settings.Items {
+		if setting.ProtocolType == protocol {
+			return &setting, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s AAA settings found", protocol)
+}
+
+func updateAAASetting(c *ftdc.Client, a aaaSetting) (*aaaSetting, error) {
+	err := doRequest(c, &a, fmt.Sprintf("devicesettings/default/aaasettings/%s", a.ID), http.MethodPut)
+	return &a, err
+}
+
+func getLocalIdentitySource(c *ftdc.Client) (*ftdc.ReferenceModel, error) {
+	return getSingleton[ftdc.ReferenceModel](c, "object/localidentitysources")
+}
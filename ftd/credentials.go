@@ -0,0 +1,121 @@
+package ftd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// credentials - what a client authenticates with, a pre-issued token or a username and password
+type credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+func (c credentials) complete() bool {
+	return c.Token != "" || (c.Username != "" && c.Password != "")
+}
+
+// credentialsFile - content of credentials_file. Entries of devices override the top level ones
+// for the device block of the same name.
+type credentialsFile struct {
+	credentials
+	Devices map[string]credentials `json:"devices"`
+}
+
+// resolveCredentials returns the credentials of device, taken in order from the provider or device
+// block, credentials_file and credential_helper. The first source with a token or a username and
+// password wins, no credentials at all leave the client unauthenticated.
+func resolveCredentials(ctx context.Context, device deviceConfig, settings clientSettings) (credentials, error) {
+	creds := credentials{
+		Username: device.username,
+		Password: device.password,
+		Token:    device.token,
+	}
+	if creds.complete() {
+		return creds, nil
+	}
+
+	if settings.credentialsFile != "" {
+		b, err := ioutil.ReadFile(settings.credentialsFile)
+		if err != nil {
+			return creds, fmt.Errorf("unable to read credentials file: %w", err)
+		}
+
+		file := credentialsFile{}
+		if err := json.Unmarshal(b, &file); err != nil {
+			return creds, fmt.Errorf("unable to parse credentials file %s: %w", settings.credentialsFile, err)
+		}
+
+		if deviceCreds, ok := file.Devices[device.name]; ok && deviceCreds.complete() {
+			return deviceCreds, nil
+		}
+		if file.complete() {
+			return file.credentials, nil
+		}
+	}
+
+	if len(settings.credentialHelper) > 0 {
+		return runCredentialHelper(ctx, settings.credentialHelper, device)
+	}
+
+	return creds, nil
+}
+
+// credentialHelperCommand returns credential_helper, or FTD_CREDENTIAL_HELPER parsed as a JSON array,
+// e.g. ["/opt/ftd/get creds", "--role", "ci"]
+func credentialHelperCommand(d *schema.ResourceData) ([]string, error) {
+	var command []string
+	for _, arg := range d.Get("credential_helper").([]interface{}) {
+		command = append(command, arg.(string))
+	}
+
+	if len(command) == 0 {
+		if env := os.Getenv("FTD_CREDENTIAL_HELPER"); env != "" {
+			if err := json.Unmarshal([]byte(env), &command); err != nil {
+				return nil, fmt.Errorf("FTD_CREDENTIAL_HELPER must be a JSON array of the command and its arguments: %w", err)
+			}
+		}
+	}
+
+	if len(command) > 0 && command[0] == "" {
+		return nil, fmt.Errorf("credential helper command is empty")
+	}
+
+	return command, nil
+}
+
+// runCredentialHelper runs the command args and reads credentials from the JSON it prints, e.g.
+// {"username": "admin", "password": "..."} or {"token": "..."}. The device name and url are
+// passed in FTD_DEVICE and FTD_URL, so one helper can serve several devices.
+func runCredentialHelper(ctx context.Context, args []string, device deviceConfig) (credentials, error) {
+	creds := credentials{}
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), "FTD_DEVICE="+device.name, "FTD_URL="+device.url)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return creds, fmt.Errorf("credential helper %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	if err := json.Unmarshal(out, &creds); err != nil {
+		return creds, fmt.Errorf("unable to parse the output of credential helper %s: %w", args[0], err)
+	}
+	if !creds.complete() {
+		return creds, fmt.Errorf("credential helper %s printed neither a token nor a username and password", args[0])
+	}
+
+	return creds, nil
+}
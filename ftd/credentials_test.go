@@ -0,0 +1,119 @@
+package ftd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestResolveCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helpers are shell scripts")
+	}
+
+	dir := t.TempDir()
+	write := func(name string, content string, mode os.FileMode) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	file := write("credentials.json", `{
+		"username": "file-user",
+		"password": "file-pass",
+		"devices": {
+			"branch": {"token": "file-branch-token"},
+			"partial": {"username": "only-user"}
+		}
+	}`, 0600)
+	emptyFile := write("empty.json", `{}`, 0600)
+	brokenFile := write("broken.json", `{`, 0600)
+	helper := []string{write("helper with space.sh", "#!/bin/sh\necho \"{\\\"username\\\": \\\"$1-$FTD_DEVICE\\\", \\\"password\\\": \\\"$FTD_URL\\\"}\"\n", 0700), "helper"}
+	emptyHelper := []string{write("empty.sh", "#!/bin/sh\necho '{}'\n", 0700)}
+	failingHelper := []string{filepath.Join(dir, "does-not-exist")}
+
+	cases := []struct {
+		name     string
+		device   deviceConfig
+		settings clientSettings
+		want     credentials
+		err      bool
+	}{
+		{
+			name:     "provider credentials win",
+			device:   deviceConfig{username: "admin", password: "pass"},
+			settings: clientSettings{credentialsFile: file, credentialHelper: failingHelper},
+			want:     credentials{Username: "admin", Password: "pass"},
+		},
+		{
+			name:     "token wins",
+			device:   deviceConfig{token: "tok"},
+			settings: clientSettings{credentialsFile: file, credentialHelper: failingHelper},
+			want:     credentials{Token: "tok"},
+		},
+		{
+			name:     "username alone falls through to the file",
+			device:   deviceConfig{username: "admin"},
+			settings: clientSettings{credentialsFile: file, credentialHelper: failingHelper},
+			want:     credentials{Username: "file-user", Password: "file-pass"},
+		},
+		{
+			name:     "device entry of the file",
+			device:   deviceConfig{name: "branch"},
+			settings: clientSettings{credentialsFile: file},
+			want:     credentials{Token: "file-branch-token"},
+		},
+		{
+			name:     "incomplete device entry uses the top level",
+			device:   deviceConfig{name: "partial"},
+			settings: clientSettings{credentialsFile: file},
+			want:     credentials{Username: "file-user", Password: "file-pass"},
+		},
+		{
+			name:     "helper after an empty file",
+			device:   deviceConfig{name: "branch", url: "https://branch"},
+			settings: clientSettings{credentialsFile: emptyFile, credentialHelper: helper},
+			want:     credentials{Username: "helper-branch", Password: "https://branch"},
+		},
+		{
+			name: "no source leaves the client unauthenticated",
+			want: credentials{},
+		},
+		{
+			name:     "unreadable file",
+			settings: clientSettings{credentialsFile: filepath.Join(dir, "missing.json")},
+			err:      true,
+		},
+		{
+			name:     "broken file",
+			settings: clientSettings{credentialsFile: brokenFile},
+			err:      true,
+		},
+		{
+			name:     "helper without credentials",
+			settings: clientSettings{credentialHelper: emptyHelper},
+			err:      true,
+		},
+		{
+			name:     "failing helper",
+			settings: clientSettings{credentialHelper: failingHelper},
+			err:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resolveCredentials(context.Background(), tc.device, tc.settings)
+			if (err != nil) != tc.err {
+				t.Fatalf("resolveCredentials() error = %v, want error %v", err, tc.err)
+			}
+			if !tc.err && got != tc.want {
+				t.Errorf("resolveCredentials() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	ftdc "github.com/mr-olenoid/ftd-client"
 )
 
 func dataSourceApplication() *schema.Resource {
@@ -87,7 +86,7 @@ func dataSourceApplication() *schema.Resource {
 
 func dataSourceApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	applications, err := c.GetApplication(d.Get("name").(string))
 	if err != nil {
@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	ftdc "github.com/mr-olenoid/ftd-client"
 )
 
 func dataSourceApplicationCategory() *schema.Resource {
@@ -38,7 +37,7 @@ func dataSourceApplicationCategory() *schema.Resource {
 
 func dataSourceApplicationCategoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	appCategory, err := c.GetApplicationCategory(d.Get("name").(string))
 	if err != nil {
@@ -0,0 +1,85 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCertificateRead,
+		Description: "Look up a certificate by name, e.g. the built-in DefaultInternalCertificate",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cert": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validfrom": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validto": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issystemdefined": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "internalcertificate",
+				Description:  "internalcertificate, internalcacertificate or externalcacertificate",
+				ValidateFunc: validation.StringInSlice([]string{"internalcertificate", "internalcacertificate", "externalcacertificate"}, false),
+			},
+		},
+	}
+}
+
+func dataSourceCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := clientFromMeta(ctx, d, m)
+
+	cert, err := getCertificateByName(c, d.Get("name").(string), d.Get("type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("version", cert.Version)
+	d.Set("name", cert.Name)
+	d.Set("cert", cert.Cert)
+	d.Set("subject", cert.SubjectCommonName)
+	d.Set("issuer", cert.IssuerCommonName)
+	d.Set("validfrom", cert.ValidityStartDate)
+	d.Set("validto", cert.ValidityEndDate)
+	d.Set("issystemdefined", cert.IsSystemDefined)
+	d.Set("type", cert.Type)
+
+	d.SetId(cert.ID)
+
+	return diags
+}
@@ -0,0 +1,127 @@
+package ftd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceLicenseStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLicenseStatusRead,
+		Description: "Smart licensing state of the device. Set requiredlicenses to fail the plan when a feature license is missing",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"requiredlicenses": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Feature licenses that must be enabled and compliant. [THREAT, MALWARE, URLFILTERING, PLUS, APEX, VPNONLY]",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(licenseTypes, false),
+				},
+			},
+			"registrationstatus": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorizationstatus": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluationdaysremaining": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"licenses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"licensetype": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliant": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLicenseStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := clientFromMeta(ctx, d, m)
+
+	status, err := getSmartAgentStatus(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	licenses, err := getLicenses(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enabled := make(map[string]bool)
+	items := make([]interface{}, len(licenses))
+	for i, l := range licenses {
+		enabled[l.LicenseType] = l.Compliant
+		items[i] = map[string]interface{}{
+			"id":          l.ID,
+			"licensetype": l.LicenseType,
+			"compliant":   l.Compliant,
+		}
+	}
+
+	for _, required := range d.Get("requiredlicenses").(*schema.Set).List() {
+		licenseType := required.(string)
+		compliant, ok := enabled[licenseType]
+		if !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Required license is not enabled",
+				Detail:   fmt.Sprintf("%s license is not enabled on the device. Enable it with ftd_license_feature.", licenseType),
+			})
+		} else if !compliant {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Required license is out of compliance",
+				Detail:   fmt.Sprintf("%s license is enabled but not compliant, registration status %s.", licenseType, status.RegistrationStatus),
+			})
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("registrationstatus", status.RegistrationStatus)
+	d.Set("authorizationstatus", status.AuthorizationStatus)
+	d.Set("evaluationdaysremaining", status.EvaluationPeriodRemaining)
+	if err := d.Set("licenses", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// status is a singleton, some versions return it without an ID
+	if status.ID == "" {
+		d.SetId(status.Type)
+	} else {
+		d.SetId(status.ID)
+	}
+
+	return diags
+}
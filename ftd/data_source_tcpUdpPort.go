@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	ftdc "github.com/mr-olenoid/ftd-client"
 )
 
 func dataSourceTcpUpdPort() *schema.Resource {
@@ -46,7 +45,7 @@ func dataSourceTcpUpdPort() *schema.Resource {
 
 func dataSourceTcpUpdPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	tcpUdpPort, err := c.GetTcpUdpPortByName(d.Get("name").(string), d.Get("type").(string))
 	if err != nil {
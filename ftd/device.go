@@ -0,0 +1,193 @@
+package ftd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// deviceConfig - FDM endpoint a client is created for, the provider url or a device block
+type deviceConfig struct {
+	name          string
+	url           string
+	username      string
+	password      string
+	token         string
+	tlsServerName string
+}
+
+// clientSettings - provider settings shared by the clients of all devices
+type clientSettings struct {
+	caCertPEM           string
+	caCertFile          string
+	insecureSkipVerify  bool
+	credentialsFile     string
+	credentialHelper    []string
+	retry               retryTransport
+	maxConcurrentWrites int
+}
+
+// providerMeta - meta of the provider, a client per device. The provider url is the device
+// named "". Clients of device blocks are created on first use, so a plan touching a few
+// of many devices only logs in to those.
+type providerMeta struct {
+	settings clientSettings
+	devices  map[string]deviceConfig
+
+	mu      sync.Mutex
+	clients map[string]*deviceClient
+}
+
+type deviceClient struct {
+	mu      sync.Mutex
+	client  *ftdc.Client
+	session *session
+}
+
+// client returns a copy of the cached client of device, creating it if there is none yet.
+// Failed logins are not cached, the next call tries again.
+func (p *providerMeta) client(ctx context.Context, device string) (ftdc.Client, error) {
+	config, ok := p.devices[device]
+	if !ok {
+		if device == "" {
+			return ftdc.Client{}, fmt.Errorf("no device set and the provider has no url")
+		}
+		return ftdc.Client{}, fmt.Errorf("unknown device %q, it is not declared in a device block of the provider", device)
+	}
+
+	p.mu.Lock()
+	dc, ok := p.clients[device]
+	if !ok {
+		dc = &deviceClient{}
+		p.clients[device] = dc
+	}
+	p.mu.Unlock()
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	if dc.client == nil {
+		c, s, err := newClient(ctx, config, p.settings)
+		if err != nil {
+			return ftdc.Client{}, err
+		}
+		dc.client = c
+		dc.session = s
+	}
+
+	// the session refreshes the token fields of the client while other calls copy it
+	if dc.session != nil {
+		return dc.session.clientCopy(), nil
+	}
+	return *dc.client, nil
+}
+
+// newClient creates a client for device with the transport chain every FDM call goes through.
+// The returned session is nil for clients without credentials.
+func newClient(ctx context.Context, device deviceConfig, settings clientSettings) (*ftdc.Client, *session, error) {
+	transport, err := newTransport(settings.caCertPEM, settings.caCertFile, settings.insecureSkipVerify, device.tlsServerName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid TLS configuration: %w", err)
+	}
+
+	// client is created without credentials, so the TLS settings are in place before the first login
+	var host *string
+	if device.url != "" {
+		host = &device.url
+	}
+	c, err := ftdc.NewClient(host, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.HTTPClient.Transport = &logTransport{next: transport}
+	// limited per call by the transport, so retries are not cut short
+	c.HTTPClient.Timeout = 0
+
+	creds, err := resolveCredentials(ctx, device, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var s *session
+	if creds.Token != "" {
+		s = newTokenSession(c, creds.Token)
+	} else if (creds.Username != "") && (creds.Password != "") {
+		c.Auth = ftdc.AuthRequest{
+			GrantType: "password",
+			Username:  creds.Username,
+			Password:  creds.Password,
+		}
+
+		s, err = newSession(ctx, c)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to authenticate to %s: %w", c.FTDURL, err)
+		}
+	}
+
+	// write locks are taken per attempt, so retries do not hold them through their backoff
+	c.HTTPClient.Transport = newWriteLockTransport(c.HTTPClient.Transport, settings.maxConcurrentWrites)
+	c.HTTPClient.Transport = newRetryTransport(c.HTTPClient.Transport, settings.retry)
+	c.HTTPClient.Transport = &notFoundTransport{next: c.HTTPClient.Transport}
+
+	return c, s, nil
+}
+
+// errorTransport fails every call, it stands in for the client of a device that could not be created
+type errorTransport struct {
+	err error
+}
+
+func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, t.err
+}
+
+// clientFromMeta returns the client of the device d is managed on, bound to the ctx of a CRUD function.
+// ftd-client does not take a context, so cancellation and tflog reach its calls through the transport.
+func clientFromMeta(ctx context.Context, d *schema.ResourceData, m interface{}) *ftdc.Client {
+	ctx = withLogSubsystem(ctx)
+
+	c, err := m.(*providerMeta).client(ctx, d.Get("device").(string))
+	if err != nil {
+		// calls fail with the reason, so CRUD functions report it like any other client error
+		return &ftdc.Client{HTTPClient: &http.Client{Transport: &errorTransport{err: err}}}
+	}
+
+	httpClient := *c.HTTPClient
+	httpClient.Transport = &contextTransport{ctx: ctx, next: c.HTTPClient.Transport}
+	c.HTTPClient = &httpClient
+
+	return &c
+}
+
+// withDevice adds the device attribute to r. Imports take the device after the ID, e.g. <id>@<device>.
+func withDevice(r *schema.Resource, forceNew bool) {
+	r.Schema["device"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    forceNew,
+		Description: "Name of the provider device block to manage this on, the provider url if not set",
+	}
+
+	if r.Importer == nil || r.Importer.StateContext == nil {
+		return
+	}
+
+	importState := r.Importer.StateContext
+	r.Importer = &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			if i := strings.LastIndex(d.Id(), "@"); i >= 0 {
+				d.Set("device", d.Id()[i+1:])
+				d.SetId(d.Id()[:i])
+			}
+			return importState(ctx, d, m)
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package ftd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fdmErrorPayload - error body FDM returns for rejected requests
+type fdmErrorPayload struct {
+	Error struct {
+		Severity string            `json:"severity"`
+		Key      string            `json:"key"`
+		Messages []fdmErrorMessage `json:"messages"`
+	} `json:"error"`
+}
+
+type fdmErrorMessage struct {
+	Description string `json:"description"`
+	Code        string `json:"code"`
+	Location    string `json:"location"`
+	Path        string `json:"path"`
+}
+
+// statusError matches the errors of ftd-client and doRequest, e.g. status: 422, body: {...}
+var statusError = regexp.MustCompile(`(?s)status: (\d+), body: (\{.*\})\s*$`)
+
+// fdmPathSegment matches one field of a FDM path, e.g. entries[2]
+var fdmPathSegment = regexp.MustCompile(`^([^\[\]]+)(?:\[(\d+)\])?$`)
+
+// withFDMDiagnostics makes every CRUD function of r split FDM error payloads into one
+// diagnostic per message, pointing at the attribute of r the message is about
+func withFDMDiagnostics(r *schema.Resource) {
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return fdmDiagnostics(f(ctx, d, m), r.Schema)
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+}
+
+// fdmDiagnostics replaces error diagnostics carrying a FDM error payload with the messages of the payload.
+// Anything else is returned as is.
+func fdmDiagnostics(diags diag.Diagnostics, s map[string]*schema.Schema) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			result = append(result, d)
+			continue
+		}
+
+		match := statusError.FindStringSubmatch(d.Summary)
+		if match == nil {
+			result = append(result, d)
+			continue
+		}
+
+		payload := fdmErrorPayload{}
+		if err := json.Unmarshal([]byte(match[2]), &payload); err != nil || len(payload.Error.Messages) == 0 {
+			result = append(result, d)
+			continue
+		}
+
+		for _, message := range payload.Error.Messages {
+			location := message.Location
+			if location == "" {
+				location = message.Path
+			}
+
+			detail := fmt.Sprintf("FDM rejected the request with status %s", match[1])
+			if message.Code != "" {
+				detail += fmt.Sprintf(", code %s", message.Code)
+			}
+			if location != "" {
+				detail += fmt.Sprintf(", field %s", location)
+			}
+
+			summary := message.Description
+			if summary == "" {
+				summary = payload.Error.Key
+			}
+
+			result = append(result, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       summary,
+				Detail:        detail,
+				AttributePath: attributePath(location, s),
+			})
+		}
+	}
+
+	return result
+}
+
+// attributePath maps a FDM field path, e.g. ipv4.ipAddress.netmask, to the schema attribute
+// it is set from, e.g. ipv4.0.ipaddress.0.netmask. The path stops at the last attribute
+// that can be resolved, nil is returned if there is none.
+func attributePath(location string, s map[string]*schema.Schema) cty.Path {
+	var path cty.Path
+
+	location = strings.Trim(strings.ReplaceAll(location, "/", "."), ".")
+	if location == "" {
+		return nil
+	}
+
+	for _, segment := range strings.Split(location, ".") {
+		match := fdmPathSegment.FindStringSubmatch(segment)
+		if match == nil || s == nil {
+			break
+		}
+
+		name := strings.ToLower(match[1])
+		attr, ok := s[name]
+		if !ok {
+			break
+		}
+		path = path.GetAttr(name)
+		s = nil
+
+		if attr.Type != schema.TypeList {
+			continue
+		}
+
+		// blocks with one element are sent as a single object
+		index := 0
+		if match[2] != "" {
+			index, _ = strconv.Atoi(match[2])
+		} else if attr.MaxItems != 1 {
+			break
+		}
+		path = path.IndexInt(index)
+
+		if elem, ok := attr.Elem.(*schema.Resource); ok {
+			s = elem.Schema
+		}
+	}
+
+	if len(path) == 0 {
+		return nil
+	}
+	return path
+}
@@ -0,0 +1,130 @@
+package ftd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAttributePath(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource string
+		location string
+		want     cty.Path
+	}{
+		{
+			name:     "nested blocks",
+			resource: "ftd_interface",
+			location: "ipv4.ipAddress.netmask",
+			want:     cty.GetAttrPath("ipv4").IndexInt(0).GetAttr("ipaddress").IndexInt(0).GetAttr("netmask"),
+		},
+		{
+			name:     "slash separated",
+			resource: "ftd_interface",
+			location: "/ipv4/ipAddress/netmask",
+			want:     cty.GetAttrPath("ipv4").IndexInt(0).GetAttr("ipaddress").IndexInt(0).GetAttr("netmask"),
+		},
+		{
+			name:     "indexed list",
+			resource: "ftd_route_map",
+			location: "entries[1].action",
+			want:     cty.GetAttrPath("entries").IndexInt(1).GetAttr("action"),
+		},
+		{
+			name:     "list without index stops at the list",
+			resource: "ftd_route_map",
+			location: "entries.action",
+			want:     cty.GetAttrPath("entries"),
+		},
+		{
+			name:     "unknown field stops at the last known one",
+			resource: "ftd_interface",
+			location: "ipv4.unknownField",
+			want:     cty.GetAttrPath("ipv4").IndexInt(0),
+		},
+		{
+			name:     "top level attribute",
+			resource: "ftd_network_object",
+			location: "name",
+			want:     cty.GetAttrPath("name"),
+		},
+		{
+			name:     "unknown attribute",
+			resource: "ftd_network_object",
+			location: "doesNotExist",
+		},
+		{
+			name:     "empty",
+			resource: "ftd_network_object",
+		},
+	}
+
+	p := Provider()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := attributePath(tc.location, p.ResourcesMap[tc.resource].Schema)
+			if !got.Equals(tc.want) {
+				t.Errorf("attributePath(%q) = %#v, want %#v", tc.location, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFDMDiagnostics(t *testing.T) {
+	payload := `{"error":{"severity":"ERROR","key":"Validation","messages":[` +
+		`{"description":"Invalid netmask","code":"invalidNetmask","location":"ipv4.ipAddress.netmask"},` +
+		`{"description":"Name already exists","code":"duplicateName","location":""}]}}`
+
+	cases := []struct {
+		name      string
+		err       error
+		summaries []string
+		paths     []cty.Path
+	}{
+		{
+			name:      "ftd-client error",
+			err:       errors.New(`Put "https://ftd/api/fdm/v6/interfaces/1": status: 422, body: ` + payload),
+			summaries: []string{"Invalid netmask", "Name already exists"},
+			paths:     []cty.Path{cty.GetAttrPath("ipv4").IndexInt(0).GetAttr("ipaddress").IndexInt(0).GetAttr("netmask"), nil},
+		},
+		{
+			name:      "body without messages",
+			err:       errors.New(`status: 500, body: {"error":{}}`),
+			summaries: []string{`status: 500, body: {"error":{}}`},
+			paths:     []cty.Path{nil},
+		},
+		{
+			name:      "body that is not JSON",
+			err:       errors.New(`status: 502, body: {bad gateway}`),
+			summaries: []string{`status: 502, body: {bad gateway}`},
+			paths:     []cty.Path{nil},
+		},
+		{
+			name:      "other error",
+			err:       errors.New("connection refused"),
+			summaries: []string{"connection refused"},
+			paths:     []cty.Path{nil},
+		},
+	}
+
+	s := resourceInterface().Schema
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := fdmDiagnostics(diag.FromErr(tc.err), s)
+			if len(diags) != len(tc.summaries) {
+				t.Fatalf("got %d diagnostics, want %d: %v", len(diags), len(tc.summaries), diags)
+			}
+			for i, d := range diags {
+				if d.Summary != tc.summaries[i] {
+					t.Errorf("diagnostic %d summary = %q, want %q", i, d.Summary, tc.summaries[i])
+				}
+				if !d.AttributePath.Equals(tc.paths[i]) {
+					t.Errorf("diagnostic %d path = %#v, want %#v", i, d.AttributePath, tc.paths[i])
+				}
+			}
+		})
+	}
+}
@@ -252,3 +252,105 @@ func returnFirstIfExists(object []ftdc.ReferenceModel) ftdc.ReferenceModel {
 	}
 	return ftdc.ReferenceModel{}
 }
+
+func flattenReference(item *ftdc.ReferenceModel) []interface{} {
+	if item != nil {
+		return flattenReferenceModel(&[]ftdc.ReferenceModel{*item})
+	}
+	return make([]interface{}, 0)
+}
+
+func restoreReference(objects interface{}) *ftdc.ReferenceModel {
+	ros := restoreReferenceObject(objects)
+	if len(ros) > 0 {
+		return &ros[0]
+	}
+	return nil
+}
+
+func containsReference(references []ftdc.ReferenceModel, reference ftdc.ReferenceModel) bool {
+	for _, r := range references {
+		if r.ID == reference.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// virtualRouterID returns the configured virtual router or the ID of the Global one
+func virtualRouterID(c *ftdc.Client, d *schema.ResourceData) (string, error) {
+	if vrId := d.Get("virtualrouterid").(string); vrId != "" {
+		return vrId, nil
+	}
+
+	vr, err := getVirtualRouterByName(c, globalVirtualRouter)
+	if err != nil {
+		return "", err
+	}
+	return vr.ID, nil
+}
+
+// syncItems creates, updates and deletes the FDM objects behind the list attribute attr, matching
+// old and new items by key. On failure the items that exist on the device at that point are written
+// to d with their IDs, so objects created before the error are not created again on the next apply.
+func syncItems(d *schema.ResourceData, attr string, key func(item map[string]interface{}) string,
+	create func(item map[string]interface{}) (string, error),
+	update func(ID string, item map[string]interface{}) error,
+	remove func(ID string) error) error {
+	o, n := d.GetChange(attr)
+
+	oldItems := o.([]interface{})
+	existing := make(map[string]string)
+	for _, item := range oldItems {
+		i := item.(map[string]interface{})
+		if ID := i["id"].(string); ID != "" {
+			existing[key(i)] = ID
+		}
+	}
+
+	var synced []interface{}
+	save := func() {
+		items := append([]interface{}{}, synced...)
+		for _, item := range oldItems {
+			i := item.(map[string]interface{})
+			if _, ok := existing[key(i)]; ok {
+				items = append(items, i)
+			}
+		}
+		d.Set(attr, items)
+	}
+
+	for _, item := range n.([]interface{}) {
+		i := item.(map[string]interface{})
+		k := key(i)
+
+		if ID, ok := existing[k]; ok {
+			if err := update(ID, i); err != nil {
+				save()
+				return err
+			}
+			i["id"] = ID
+			delete(existing, k)
+		} else {
+			ID, err := create(i)
+			if err != nil {
+				save()
+				return err
+			}
+			i["id"] = ID
+		}
+
+		synced = append(synced, i)
+	}
+
+	for k, ID := range existing {
+		if err := remove(ID); err != nil {
+			save()
+			return err
+		}
+		delete(existing, k)
+	}
+
+	d.Set(attr, synced)
+	return nil
+}
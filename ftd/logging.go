@@ -0,0 +1,149 @@
+package ftd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem - FDM calls are logged under this subsystem, shown with TF_LOG=DEBUG
+const logSubsystem = "ftd"
+
+// redacted replaces the values of sensitive fields in logged bodies
+const redacted = "***"
+
+// logTransport logs every call sent to FDM with its duration and bodies, sensitive values masked.
+// It sits right above the network, so retries and token requests are logged one by one.
+type logTransport struct {
+	next http.RoundTripper
+}
+
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.RequestURI(),
+	}
+	if body := requestBody(req); body != nil {
+		fields["request_body"] = redactBody(body)
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Sending FDM request", fields)
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	delete(fields, "request_body")
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystem, "FDM request failed", fields)
+		return res, err
+	}
+
+	fields["status"] = res.StatusCode
+	if body := responseBody(res); body != nil {
+		fields["response_body"] = redactBody(body)
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Received FDM response", fields)
+
+	return res, err
+}
+
+// requestBody returns a copy of the request body, nil for requests without one
+func requestBody(req *http.Request) []byte {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil
+		}
+		defer rc.Close()
+		b, _ := ioutil.ReadAll(rc)
+		return b
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// responseBody returns a copy of a JSON response body. Other bodies, e.g. backup archives, are not read.
+func responseBody(res *http.Response) []byte {
+	if res.Body == nil || !strings.Contains(res.Header.Get("Content-Type"), "json") {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// redactBody returns body with passwords, secrets, tokens and keys masked
+func redactBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	return string(b)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			if isSensitiveField(k) && item != nil {
+				value[k] = redacted
+				continue
+			}
+			value[k] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+	}
+	return v
+}
+
+// isSensitiveField reports whether a JSON field holds a secret, e.g. password, sharedKey, keyValue or refresh_token
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, s := range []string{"password", "secret", "token", "community", "passphrase", "keyvalue"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+
+	// "key" alone is the error key of FDM error payloads
+	return name != "key" && strings.HasSuffix(name, "key")
+}
+
+// withLogSubsystem returns ctx with the logger FDM calls are logged to
+func withLogSubsystem(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, logSubsystem)
+}
@@ -0,0 +1,143 @@
+package ftd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogTransport(t *testing.T) {
+	var output bytes.Buffer
+	ctx := withLogSubsystem(tflogtest.RootLogger(context.Background(), &output))
+
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"access_token":"secret-token","expires_in":1800}`)),
+		}, nil
+	})
+	transport := &logTransport{next: next}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://ftd/api/fdm/v6/fdm/token", strings.NewReader(`{"username":"admin","password":"secret-password"}`))
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the caller still gets the whole response body
+	body, _ := ioutil.ReadAll(res.Body)
+	if !strings.Contains(string(body), "secret-token") {
+		t.Errorf("response body was consumed by logging: %s", body)
+	}
+
+	logged := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d log entries, want 2", len(entries))
+	}
+	for _, secret := range []string{"secret-token", "secret-password"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains %s", secret)
+		}
+	}
+	if entries[1]["status"] != float64(http.StatusOK) || entries[1]["path"] != "/api/fdm/v6/fdm/token" {
+		t.Errorf("unexpected response entry %v", entries[1])
+	}
+}
+
+func TestIsSensitiveField(t *testing.T) {
+	cases := []struct {
+		name      string
+		sensitive bool
+	}{
+		{"password", true},
+		{"authenticationPassword", true},
+		{"serverSecretKey", true},
+		{"neighborSecret", true},
+		{"access_token", true},
+		{"refresh_token", true},
+		{"token_to_revoke", true},
+		{"privateKey", true},
+		{"sharedKey", true},
+		{"authKey", true},
+		{"community", true},
+		{"passPhrase", true},
+		{"keyValue", true},
+		{"key", false},
+		{"keyType", false},
+		{"keyId", false},
+		{"md5KeyId", false},
+		{"name", false},
+		{"version", false},
+		{"expires_in", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isSensitiveField(tc.name); got != tc.sensitive {
+				t.Errorf("isSensitiveField(%q) = %v, want %v", tc.name, got, tc.sensitive)
+			}
+		})
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "login",
+			body: `{"grant_type":"password","username":"admin","password":"hunter2"}`,
+			want: `{"grant_type":"password","password":"***","username":"admin"}`,
+		},
+		{
+			name: "token response",
+			body: `{"access_token":"abc","expires_in":1800,"refresh_token":"def"}`,
+			want: `{"access_token":"***","expires_in":1800,"refresh_token":"***"}`,
+		},
+		{
+			name: "nested secrets",
+			body: `{"name":"bgp","neighbors":[{"address":"10.0.0.1","password":"p"},{"address":"10.0.0.2"}]}`,
+			want: `{"name":"bgp","neighbors":[{"address":"10.0.0.1","password":"***"},{"address":"10.0.0.2"}]}`,
+		},
+		{
+			name: "ntp server keys",
+			body: `{"ntpServerKeys":[{"keyId":1,"keyType":"SHA256","keyValue":"k","server":"10.0.0.1"}]}`,
+			want: `{"ntpServerKeys":[{"keyId":1,"keyType":"SHA256","keyValue":"***","server":"10.0.0.1"}]}`,
+		},
+		{
+			name: "non string secret",
+			body: `{"sharedKey":{"value":"s"},"authKey":null}`,
+			want: `{"authKey":null,"sharedKey":"***"}`,
+		},
+		{
+			name: "error payload key is kept",
+			body: `{"error":{"key":"Validation","messages":[]}}`,
+			want: `{"error":{"key":"Validation","messages":[]}}`,
+		},
+		{
+			name: "not JSON",
+			body: `password=hunter2`,
+			want: `<16 bytes>`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := redactBody([]byte(tc.body)); got != tc.want {
+				t.Errorf("redactBody() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
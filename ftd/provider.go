@@ -2,16 +2,18 @@ package ftd
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	ftdClient "github.com/mr-olenoid/ftd-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider -
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:        schema.TypeString,
@@ -30,26 +32,205 @@ func Provider() *schema.Provider {
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("FTD_URL", nil),
 			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Pre-issued FDM access token, used instead of username and password. It is not refreshed and, as it is only valid on the device that issued it, not passed on to device blocks",
+				DefaultFunc: schema.EnvDefaultFunc("FTD_TOKEN", nil),
+			},
+			"credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a JSON file with username and password or token, per device below devices. Used when they are not set on the provider",
+				DefaultFunc: schema.EnvDefaultFunc("FTD_CREDENTIALS_FILE", nil),
+			},
+			"credential_helper": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Command and its arguments printing JSON with username and password or token, FTD_DEVICE and FTD_URL name the device. Used when neither the provider nor credentials_file has credentials. FTD_CREDENTIAL_HELPER takes it as a JSON array",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "PEM encoded CA certificate the FDM certificate is verified against, in addition to the system roots",
+				DefaultFunc:   schema.EnvDefaultFunc("FTD_CA_CERT_PEM", nil),
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to a PEM encoded CA certificate, alternative to ca_cert_pem",
+				DefaultFunc:   schema.EnvDefaultFunc("FTD_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Do not verify the FDM certificate. Only for lab devices with self-signed certificates",
+				DefaultFunc: schema.EnvDefaultFunc("FTD_INSECURE_SKIP_VERIFY", false),
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name the FDM certificate is verified for, when url uses an IP address or a different name",
+				DefaultFunc: schema.EnvDefaultFunc("FTD_TLS_SERVER_NAME", nil),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Times a failed FDM call with a retryable status is sent again",
+				DefaultFunc:  schema.EnvDefaultFunc("FTD_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"retry_min_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Seconds to wait before the first retry, doubled for every next one",
+				DefaultFunc:  schema.EnvDefaultFunc("FTD_RETRY_MIN_BACKOFF", 1),
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum seconds to wait between retries",
+				DefaultFunc:  schema.EnvDefaultFunc("FTD_RETRY_MAX_BACKOFF", 30),
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"max_concurrent_writes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "FDM writes sent at the same time. Writes to the same object type or access policy are always sent one by one, reads are not limited",
+				DefaultFunc:  schema.EnvDefaultFunc("FTD_MAX_CONCURRENT_WRITES", 4),
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"retryable_status_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "HTTP statuses that are retried. Defaults to 502, 503 and 504. 422 can not be retried, FDM answers it for objects changed since they were read",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+					ValidateFunc: validation.All(
+						validation.IntBetween(400, 599),
+						validation.IntNotInSlice([]int{http.StatusUnprocessableEntity}),
+					),
+				},
+			},
+			"device": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Named FDM endpoint resources and data sources can be managed on with their device attribute",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Defaults to the provider username",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Defaults to the provider password",
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Pre-issued access token of this device. Unlike username and password it does not default to the provider one",
+						},
+						"tls_server_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Defaults to the provider tls_server_name",
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"ftd_security_zone":      resourceSecurityZone(),
-			"ftd_network_object":     resourceNetworkObject(),
-			"ftd_interface":          resourceInterface(),
-			"ftd_access_rule":        resourceAccessRule(),
-			"ftd_access_policy":      resourceAccessPolicy(),
-			"ftd_tcp_udp_port_user":  resourceTcpUdpPort(),
-			"ftd_application_filter": resourceApplicationFilter(),
+			"ftd_security_zone":          resourceSecurityZone(),
+			"ftd_network_object":         resourceNetworkObject(),
+			"ftd_interface":              resourceInterface(),
+			"ftd_access_rule":            resourceAccessRule(),
+			"ftd_access_policy":          resourceAccessPolicy(),
+			"ftd_tcp_udp_port_user":      resourceTcpUdpPort(),
+			"ftd_application_filter":     resourceApplicationFilter(),
+			"ftd_dns_server_group":       resourceDNSServerGroup(),
+			"ftd_dns_settings":           resourceDNSSettings(),
+			"ftd_ntp_settings":           resourceNTPSettings(),
+			"ftd_dhcp_server":            resourceDHCPServer(),
+			"ftd_management_access":      resourceManagementAccess(),
+			"ftd_snmp_server":            resourceSNMPServer(),
+			"ftd_snmp_host":              resourceSNMPHost(),
+			"ftd_ospf":                   resourceOSPF(),
+			"ftd_bgp_general_settings":   resourceBGPGeneralSettings(),
+			"ftd_bgp":                    resourceBGP(),
+			"ftd_standard_access_list":   resourceStandardAccessList(),
+			"ftd_extended_access_list":   resourceExtendedAccessList(),
+			"ftd_prefix_list":            resourcePrefixList(),
+			"ftd_as_path_list":           resourceASPathList(),
+			"ftd_route_map":              resourceRouteMap(),
+			"ftd_ha_configuration":       resourceHAConfiguration(),
+			"ftd_smart_license":          resourceSmartLicense(),
+			"ftd_license_feature":        resourceLicenseFeature(),
+			"ftd_backup":                 resourceBackup(),
+			"ftd_backup_schedule":        resourceBackupSchedule(),
+			"ftd_device_settings":        resourceDeviceSettings(),
+			"ftd_local_user":             resourceLocalUser(),
+			"ftd_external_auth":          resourceExternalAuth(),
+			"ftd_radius_server":          resourceRadiusServer(),
+			"ftd_radius_server_group":    resourceRadiusServerGroup(),
+			"ftd_internal_certificate":   resourceInternalCertificate(),
+			"ftd_trusted_ca_certificate": resourceTrustedCACertificate(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"ftd_tcp_udp_port":         dataSourceTcpUpdPort(),
 			"ftd_application":          dataSourceApplication(),
 			"ftd_application_category": dataSourceApplicationCategory(),
+			"ftd_license_status":       dataSourceLicenseStatus(),
+			"ftd_certificate":          dataSourceCertificate(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for _, r := range p.ResourcesMap {
+		withDevice(r, true)
+		withFDMDiagnostics(r)
+	}
+	for _, r := range p.DataSourcesMap {
+		withDevice(r, false)
+		withFDMDiagnostics(r)
+	}
+
+	return p
+}
+
+// defaultTimeouts bound every CRUD call of a resource, the ctx deadline reaches FDM calls through
+// clientFromMeta. Resources waiting for the device, e.g. HA or backups, declare longer ones.
+func defaultTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(10 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Update: schema.DefaultTimeout(10 * time.Minute),
+		Delete: schema.DefaultTimeout(10 * time.Minute),
+	}
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	ctx = withLogSubsystem(ctx)
+
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
 	url := d.Get("url").(string)
@@ -57,29 +238,85 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	if (username != "") && (password != "") {
-		c, err := ftdClient.NewClient(&url, &username, &password)
-		if err != nil {
+	meta := &providerMeta{
+		settings: clientSettings{
+			caCertPEM:           d.Get("ca_cert_pem").(string),
+			caCertFile:          d.Get("ca_cert_file").(string),
+			insecureSkipVerify:  d.Get("insecure_skip_verify").(bool),
+			credentialsFile:     d.Get("credentials_file").(string),
+			retry:               retrySettings(d),
+			maxConcurrentWrites: d.Get("max_concurrent_writes").(int),
+		},
+		devices: make(map[string]deviceConfig),
+		clients: make(map[string]*deviceClient),
+	}
+
+	helper, err := credentialHelperCommand(d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid credential helper",
+			Detail:   err.Error(),
+		})
+		return nil, diags
+	}
+	meta.settings.credentialHelper = helper
+
+	for _, item := range d.Get("device").([]interface{}) {
+		device := item.(map[string]interface{})
+		name := device["name"].(string)
+
+		if _, ok := meta.devices[name]; ok {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "Unable to create HashiCups client",
-				Detail:   "Unable to auth user for authenticated HashiCups client",
+				Summary:  "Duplicate device",
+				Detail:   fmt.Sprintf("device %q is declared more than once", name),
 			})
 			return nil, diags
 		}
 
-		return c, diags
+		// credentials not set on the device are the ones of the provider
+		config := deviceConfig{
+			name:          name,
+			url:           device["url"].(string),
+			username:      device["username"].(string),
+			password:      device["password"].(string),
+			token:         device["token"].(string),
+			tlsServerName: device["tls_server_name"].(string),
+		}
+		if config.username == "" {
+			config.username = username
+		}
+		if config.password == "" {
+			config.password = password
+		}
+		if config.tlsServerName == "" {
+			config.tlsServerName = d.Get("tls_server_name").(string)
+		}
+		meta.devices[name] = config
 	}
 
-	c, err := ftdClient.NewClient(nil, nil, nil)
-	if err != nil {
+	// a provider with only device blocks has no default device
+	if url == "" && len(meta.devices) > 0 {
+		return meta, diags
+	}
+
+	meta.devices[""] = deviceConfig{
+		url:           url,
+		username:      username,
+		password:      password,
+		token:         d.Get("token").(string),
+		tlsServerName: d.Get("tls_server_name").(string),
+	}
+
+	if _, err := meta.client(ctx, ""); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "Unable to create HashiCups client",
-			Detail:   "Unable to auth user for unauthenticated HashiCups client",
+			Summary:  "Unable to create FTD client",
+			Detail:   err.Error(),
 		})
 		return nil, diags
 	}
 
-	return c, diags
+	return meta, diags
 }
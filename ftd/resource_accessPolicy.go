@@ -15,6 +15,7 @@ func resourceAccessPolicy() *schema.Resource {
 		DeleteContext: resourceAccessPolicyDelete,
 		CreateContext: resourceAccessPolicyCreate,
 		Description:   "NGFW-Access-Policy parent for every access rule in Cisco FTD. Create will import defaul access policy",
+		Timeouts:      defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -225,12 +226,12 @@ func resourceAccessPolicy() *schema.Resource {
 }
 
 func resourceAccessPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var diags diag.Diagnostics
 
 	accessPolicy, err := c.GetAccessPolicy(d.Get("id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	d.Set("id", accessPolicy.ID)
@@ -275,7 +276,7 @@ func resourceAccessPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var diags diag.Diagnostics
 
 	var accessPolicy ftdc.AccessPolicy
@@ -336,7 +337,7 @@ func resourceAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, m i
 
 func resourceAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	accessPolicy, err := c.CreateAccessPolicy(d.Get("name").(string))
 	if err != nil {
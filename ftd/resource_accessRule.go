@@ -14,6 +14,7 @@ func resourceAccessRule() *schema.Resource {
 		CreateContext: resourceAccessRuleCreate,
 		UpdateContext: resourceAccessRuleUpdate,
 		DeleteContext: resourceAccessRuleDelete,
+		Timeouts:      defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:        schema.TypeString,
@@ -648,7 +649,7 @@ func resourceAccessRule() *schema.Resource {
 }
 
 func resourceAccessRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -657,7 +658,7 @@ func resourceAccessRuleRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	accessRule, err := c.GetAccessRule(accessPolicyId, accessRuleId)
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	d.Set("version", accessRule.Version)
@@ -753,7 +754,7 @@ func resourceAccessRuleRead(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceAccessRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	accessRule := createAccessRule(d)
@@ -770,7 +771,7 @@ func resourceAccessRuleCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	accessRule := createAccessRule(d)
@@ -786,14 +787,14 @@ func resourceAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceAccessRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	var accessRule ftdc.AccessRule
 	accessRule.ID = d.Get("id").(string)
 	err := c.DeleteAccessRule(d.Get("accesspolicyid").(string), accessRule)
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(err)
 	}
 	return diags
 }
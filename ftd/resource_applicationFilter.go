@@ -14,6 +14,7 @@ func resourceApplicationFilter() *schema.Resource {
 		CreateContext: resourceApplicationFilterCreate,
 		UpdateContext: resourceApplicationFilterUpdate,
 		DeleteContext: resourceApplicationFilterDelete,
+		Timeouts:      defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:        schema.TypeString,
@@ -188,12 +189,12 @@ func resourceApplicationFilter() *schema.Resource {
 }
 
 func resourceApplicationFilterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var diags diag.Diagnostics
 
 	applicationFilter, err := c.GetApplicationFilter(d.Get("id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	d.Set("id", applicationFilter.ID)
@@ -218,7 +219,7 @@ func resourceApplicationFilterRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceApplicationFilterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var diags diag.Diagnostics
 	var applicationFilter ftdc.ApplicationFilter
 
@@ -241,7 +242,7 @@ func resourceApplicationFilterCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceApplicationFilterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var diags diag.Diagnostics
 	var applicationFilter ftdc.ApplicationFilter
 
@@ -263,7 +264,7 @@ func resourceApplicationFilterUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceApplicationFilterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var diags diag.Diagnostics
 	var applicationFilter ftdc.ApplicationFilter
 
@@ -271,7 +272,7 @@ func resourceApplicationFilterDelete(ctx context.Context, d *schema.ResourceData
 
 	err := c.DeleteApplicationFilter(applicationFilter)
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(err)
 	}
 
 	return diags
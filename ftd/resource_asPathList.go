@@ -0,0 +1,165 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceASPathList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceASPathListRead,
+		CreateContext: resourceASPathListCreate,
+		UpdateContext: resourceASPathListUpdate,
+		DeleteContext: resourceASPathListDelete,
+		Description:   "BGP AS path access list. Used by route maps",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Number from 1 to 500",
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Entries evaluated in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "[PERMIT, DENY]",
+							ValidateFunc: validation.StringInSlice([]string{"PERMIT", "DENY"}, false),
+						},
+						"regularexpression": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Regular expression matched against the AS path. ^65001_ or _65002$",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "aspathlistentry",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aspathlist",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceASPathListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	list, err := getASPathList(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", list.ID)
+	d.Set("version", list.Version)
+	d.Set("name", list.Name)
+
+	entries := make([]interface{}, len(list.Entries))
+	for i, entry := range list.Entries {
+		entries[i] = map[string]interface{}{
+			"action":            entry.Action,
+			"regularexpression": entry.RegularExpression,
+			"type":              entry.Type,
+		}
+	}
+	if err := d.Set("entries", entries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", list.Type)
+
+	return diags
+}
+
+func resourceASPathListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	list, err := createASPathList(c, createASPathListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(list.ID)
+	resourceASPathListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceASPathListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateASPathList(c, createASPathListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceASPathListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceASPathListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var list asPathList
+	list.ID = d.Get("id").(string)
+
+	err := deleteASPathList(c, list)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createASPathListModel(d *schema.ResourceData) asPathList {
+	var list asPathList
+
+	list.ID = d.Get("id").(string)
+	list.Version = d.Get("version").(string)
+	list.Name = d.Get("name").(string)
+
+	list.Entries = []asPathListEntry{}
+	for _, entry := range d.Get("entries").([]interface{}) {
+		e := entry.(map[string]interface{})
+		list.Entries = append(list.Entries, asPathListEntry{
+			Action:            e["action"].(string),
+			RegularExpression: e["regularexpression"].(string),
+			Type:              e["type"].(string),
+		})
+	}
+
+	list.Type = d.Get("type").(string)
+
+	return list
+}
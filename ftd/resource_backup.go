@@ -0,0 +1,215 @@
+package ftd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// backupPollInterval is how often the backup job is checked while waiting for completion
+const backupPollInterval = 10 * time.Second
+
+func resourceBackup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceBackupRead,
+		CreateContext: resourceBackupCreate,
+		DeleteContext: resourceBackupDelete,
+		CustomizeDiff: resourceBackupCustomizeDiff,
+		Description:   "On-demand FDM backup. Change triggers to take a new one. Destroy only removes it from state, the archive stays on the device",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that take a new backup when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"waitforcompletion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Wait until the backup job finishes. Required for downloadpath",
+			},
+			"downloadpath": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local file the archive is downloaded to",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 of the downloaded archive",
+			},
+			"archivename": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jobid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "backupimmediate",
+			},
+		},
+	}
+}
+
+func resourceBackupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	// FDM prunes its job history, a backup that already ran keeps its last known status
+	job, err := getBackupJob(c, d.Get("jobid").(string))
+	if isNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("status", job.Status)
+	d.Set("archivename", job.ArchiveName)
+
+	return diags
+}
+
+func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	var backup backupImmediate
+	backup.Name = d.Get("name").(string)
+	backup.BackupDescription = d.Get("description").(string)
+	backup.Type = d.Get("type").(string)
+
+	created, err := createBackupImmediate(c, backup)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(created.ID)
+	d.Set("jobid", created.JobHistoryUuid)
+
+	if !d.Get("waitforcompletion").(bool) {
+		return resourceBackupRead(ctx, d, m)
+	}
+
+	job, err := waitForBackup(ctx, c, created.JobHistoryUuid, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if path := d.Get("downloadpath").(string); path != "" {
+		checksum, err := downloadBackup(c, job.ArchiveName, path)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("checksum", checksum)
+	}
+
+	return resourceBackupRead(ctx, d, m)
+}
+
+// resourceBackupCustomizeDiff rejects downloadpath without waitforcompletion at plan time
+func resourceBackupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("downloadpath").(string) != "" && !d.Get("waitforcompletion").(bool) {
+		return fmt.Errorf("downloadpath requires waitforcompletion")
+	}
+	return nil
+}
+
+func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+// waitForBackup polls the backup job until it succeeds or fails
+func waitForBackup(ctx context.Context, c *ftdc.Client, jobID string, timeout time.Duration) (*backupJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(backupPollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := getBackupJob(c, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case "SUCCESS":
+			return job, nil
+		case "FAILED":
+			return nil, fmt.Errorf("backup failed: %s", job.StatusMessage)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("backup not finished after %s, status %s", timeout, job.Status)
+		case <-ticker.C:
+		}
+	}
+}
+
+// downloadBackup stores the archive at path and returns its SHA256
+func downloadBackup(c *ftdc.Client, archiveName string, path string) (string, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if err := downloadFile(c, backupArchivePath(archiveName), io.MultiWriter(f, hash)); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
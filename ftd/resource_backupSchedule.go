@@ -0,0 +1,160 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceBackupScheduleRead,
+		CreateContext: resourceBackupScheduleCreate,
+		UpdateContext: resourceBackupScheduleUpdate,
+		DeleteContext: resourceBackupScheduleDelete,
+		Description:   "Recurring FDM backup",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "[DAILY, WEEKLY, MONTHLY]",
+				ValidateFunc: validation.StringInSlice([]string{"DAILY", "WEEKLY", "MONTHLY"}, false),
+			},
+			"runtime": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Start time in HH:MM, UTC",
+			},
+			"daysofweek": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Days a WEEKLY backup runs. [MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY]",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}, false),
+				},
+			},
+			"dayofmonth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Day a MONTHLY backup runs",
+				ValidateFunc: validation.IntBetween(1, 31),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "scheduledbackup",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBackupScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	backup, err := getScheduledBackup(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", backup.ID)
+	d.Set("version", backup.Version)
+	d.Set("name", backup.Name)
+	d.Set("description", backup.BackupDescription)
+	d.Set("frequency", backup.ScheduleType)
+	d.Set("runtime", backup.RunTimes)
+	if err := d.Set("daysofweek", backup.DaysOfWeek); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("dayofmonth", backup.DayOfMonth)
+	d.Set("type", backup.Type)
+
+	return diags
+}
+
+func resourceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	backup, err := createScheduledBackup(c, createBackupScheduleModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(backup.ID)
+	resourceBackupScheduleRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceBackupScheduleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateScheduledBackup(c, createBackupScheduleModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceBackupScheduleRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var backup scheduledBackup
+	backup.ID = d.Get("id").(string)
+
+	err := deleteScheduledBackup(c, backup)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createBackupScheduleModel(d *schema.ResourceData) scheduledBackup {
+	var backup scheduledBackup
+
+	backup.ID = d.Get("id").(string)
+	backup.Version = d.Get("version").(string)
+	backup.Name = d.Get("name").(string)
+	backup.BackupDescription = d.Get("description").(string)
+	backup.ScheduleType = d.Get("frequency").(string)
+	backup.RunTimes = d.Get("runtime").(string)
+
+	for _, day := range d.Get("daysofweek").(*schema.Set).List() {
+		backup.DaysOfWeek = append(backup.DaysOfWeek, day.(string))
+	}
+
+	backup.DayOfMonth = d.Get("dayofmonth").(int)
+	backup.Type = d.Get("type").(string)
+
+	return backup
+}
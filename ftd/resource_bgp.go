@@ -0,0 +1,437 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBGP() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceBGPRead,
+		CreateContext: resourceBGPCreate,
+		UpdateContext: resourceBGPUpdate,
+		DeleteContext: resourceBGPDelete,
+		Description:   "BGP process with IPv4 neighbors and advertised networks. Requires ftd_bgp_general_settings",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtualrouterid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Virtual router the process runs in. Global virtual router is used if empty",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"asnumber": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Autonomous system number, must match ftd_bgp_general_settings asnumber",
+			},
+			"routerid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Router ID in IPv4 address format. Router ID from general settings is used if empty",
+			},
+			"networks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Networks advertised by the IPv4 address family",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "Network object to advertise",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "networkobject",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"routemap": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Route map applied to the advertisement",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "routemap",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"neighbors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Neighbor IPv4 address",
+						},
+						"remoteas": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Autonomous system of the neighbor",
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"activate": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"shutdown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "MD5 password for the TCP session with this neighbor. It is masked when FDM returns the BGP settings",
+						},
+						"keepalive": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"holdtime": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      180,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"minholdtime": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"routemapin": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Route map applied to routes received from the neighbor",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "routemap",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"routemapout": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Route map applied to routes advertised to the neighbor",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "routemap",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"prefixlistin": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Prefix list applied to routes received from the neighbor",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "ipv4prefixlist",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"prefixlistout": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Prefix list applied to routes advertised to the neighbor",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "ipv4prefixlist",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "bgp",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBGPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	vrId, err := virtualRouterID(c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b, err := getBGP(c, vrId, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", b.ID)
+	d.Set("version", b.Version)
+	d.Set("virtualrouterid", vrId)
+	d.Set("name", b.Name)
+	d.Set("asnumber", b.AsNumber)
+	d.Set("routerid", b.RouterId)
+
+	networks := make([]interface{}, len(b.AddressFamilyIPv4.Networks))
+	for i, network := range b.AddressFamilyIPv4.Networks {
+		networks[i] = map[string]interface{}{
+			"network":  flattenReference(&network.Ipv4Address),
+			"routemap": flattenReference(network.RouteMap),
+		}
+	}
+	if err := d.Set("networks", networks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// neighbor passwords come back masked, match the configured ones by neighbor address
+	passwords := make(map[string]string)
+	for _, neighbor := range d.Get("neighbors").([]interface{}) {
+		n := neighbor.(map[string]interface{})
+		passwords[n["address"].(string)] = n["password"].(string)
+	}
+
+	neighbors := make([]interface{}, len(b.AddressFamilyIPv4.Neighbors))
+	for i, neighbor := range b.AddressFamilyIPv4.Neighbors {
+		neighbors[i] = map[string]interface{}{
+			"address":       neighbor.Ipv4Address,
+			"remoteas":      neighbor.RemoteAs,
+			"description":   neighbor.NeighborGeneral.Description,
+			"activate":      neighbor.Activate,
+			"shutdown":      neighbor.NeighborGeneral.Shutdown,
+			"password":      passwords[neighbor.Ipv4Address],
+			"keepalive":     neighbor.NeighborTimers.KeepAliveInterval,
+			"holdtime":      neighbor.NeighborTimers.HoldTime,
+			"minholdtime":   neighbor.NeighborTimers.MinimumHoldTime,
+			"routemapin":    flattenReference(neighbor.NeighborRoutes.IncomingRouteMap),
+			"routemapout":   flattenReference(neighbor.NeighborRoutes.OutgoingRouteMap),
+			"prefixlistin":  flattenReference(neighbor.NeighborRoutes.IncomingPrefixList),
+			"prefixlistout": flattenReference(neighbor.NeighborRoutes.OutgoingPrefixList),
+		}
+	}
+	if err := d.Set("neighbors", neighbors); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", b.Type)
+
+	return diags
+}
+
+func resourceBGPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	vrId, err := virtualRouterID(c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("virtualrouterid", vrId)
+
+	b, err := createBGP(c, vrId, createBGPModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(b.ID)
+	resourceBGPRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceBGPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateBGP(c, d.Get("virtualrouterid").(string), createBGPModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceBGPRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceBGPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var b bgp
+	b.ID = d.Get("id").(string)
+
+	err := deleteBGP(c, d.Get("virtualrouterid").(string), b)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createBGPModel(d *schema.ResourceData) bgp {
+	var b bgp
+
+	b.ID = d.Get("id").(string)
+	b.Version = d.Get("version").(string)
+	b.Name = d.Get("name").(string)
+	b.AsNumber = d.Get("asnumber").(string)
+	b.RouterId = d.Get("routerid").(string)
+	b.Type = d.Get("type").(string)
+
+	b.AddressFamilyIPv4 = bgpAddressFamilyIPv4{
+		Networks:  []bgpNetwork{},
+		Neighbors: []bgpNeighbor{},
+		Type:      "afipv4",
+	}
+
+	for _, network := range d.Get("networks").([]interface{}) {
+		n := network.(map[string]interface{})
+		b.AddressFamilyIPv4.Networks = append(b.AddressFamilyIPv4.Networks, bgpNetwork{
+			Ipv4Address: returnFirstIfExists(restoreReferenceObject(n["network"])),
+			RouteMap:    restoreReference(n["routemap"]),
+			Type:        "afipv4network",
+		})
+	}
+
+	for _, neighbor := range d.Get("neighbors").([]interface{}) {
+		n := neighbor.(map[string]interface{})
+		b.AddressFamilyIPv4.Neighbors = append(b.AddressFamilyIPv4.Neighbors, bgpNeighbor{
+			Ipv4Address: n["address"].(string),
+			RemoteAs:    n["remoteas"].(string),
+			Activate:    n["activate"].(bool),
+			NeighborGeneral: bgpNeighborGeneral{
+				Description: n["description"].(string),
+				Shutdown:    n["shutdown"].(bool),
+				Type:        "neighborgeneral",
+			},
+			NeighborAdvanced: bgpNeighborAdvanced{
+				NeighborSecret: n["password"].(string),
+				Type:           "neighboradvanced",
+			},
+			NeighborTimers: bgpNeighborTimers{
+				KeepAliveInterval: n["keepalive"].(int),
+				HoldTime:          n["holdtime"].(int),
+				MinimumHoldTime:   n["minholdtime"].(int),
+				Type:              "neighbortimers",
+			},
+			NeighborRoutes: bgpNeighborRoutes{
+				IncomingRouteMap:   restoreReference(n["routemapin"]),
+				OutgoingRouteMap:   restoreReference(n["routemapout"]),
+				IncomingPrefixList: restoreReference(n["prefixlistin"]),
+				OutgoingPrefixList: restoreReference(n["prefixlistout"]),
+				Type:               "neighborroutes",
+			},
+			Type: "neighboripv4",
+		})
+	}
+
+	return b
+}
@@ -0,0 +1,198 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBGPGeneralSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceBGPGeneralSettingsRead,
+		CreateContext: resourceBGPGeneralSettingsCreate,
+		UpdateContext: resourceBGPGeneralSettingsUpdate,
+		DeleteContext: resourceBGPGeneralSettingsDelete,
+		Description:   "Device wide BGP settings. Must exist before ftd_bgp. Create will import existing settings",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "BGPGeneralSettings",
+			},
+			"asnumber": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Autonomous system number in asplain (65001) or asdot (1.10) notation",
+			},
+			"routerid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Router ID in IPv4 address format. Selected automatically if empty",
+			},
+			"scantime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				Description:  "Seconds, from 5 to 60, between scans of BGP routers for next hop validation",
+				ValidateFunc: validation.IntBetween(5, 60),
+			},
+			"aggregatetimer": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				Description:  "Seconds, from 6 to 60, between route aggregations",
+				ValidateFunc: validation.IntBetween(6, 60),
+			},
+			"keepalive": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"holdtime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      180,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"minholdtime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"logneighborchanges": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"fastexternalfallover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "bgpgeneralsettings",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBGPGeneralSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	settings, err := getBGPGeneralSettings(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", settings.ID)
+	d.Set("version", settings.Version)
+	d.Set("name", settings.Name)
+	d.Set("asnumber", settings.AsNumber)
+	d.Set("routerid", settings.RouterId)
+	d.Set("scantime", settings.ScanTime)
+	d.Set("aggregatetimer", settings.AggregateTimer)
+	d.Set("keepalive", settings.BgpTimers.KeepAlive)
+	d.Set("holdtime", settings.BgpTimers.HoldTime)
+	d.Set("minholdtime", settings.BgpTimers.MinHoldTime)
+	d.Set("logneighborchanges", settings.LogNeighborChanges)
+	d.Set("fastexternalfallover", settings.FastExternalFallOver)
+	d.Set("type", settings.Type)
+
+	return diags
+}
+
+func resourceBGPGeneralSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	existing, err := listBGPGeneralSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only one object can exist, adopt it when BGP was already configured
+	if existing != nil {
+		d.SetId(existing.ID)
+		d.Set("version", existing.Version)
+		return resourceBGPGeneralSettingsUpdate(ctx, d, m)
+	}
+
+	settings, err := createBGPGeneralSettings(c, createBGPGeneralSettingsModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(settings.ID)
+
+	return resourceBGPGeneralSettingsRead(ctx, d, m)
+}
+
+func resourceBGPGeneralSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateBGPGeneralSettings(c, createBGPGeneralSettingsModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceBGPGeneralSettingsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceBGPGeneralSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var settings bgpGeneralSettings
+	settings.ID = d.Get("id").(string)
+
+	err := deleteBGPGeneralSettings(c, settings)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createBGPGeneralSettingsModel(d *schema.ResourceData) bgpGeneralSettings {
+	var settings bgpGeneralSettings
+
+	settings.ID = d.Get("id").(string)
+	settings.Version = d.Get("version").(string)
+	settings.Name = d.Get("name").(string)
+	settings.AsNumber = d.Get("asnumber").(string)
+	settings.RouterId = d.Get("routerid").(string)
+	settings.ScanTime = d.Get("scantime").(int)
+	settings.AggregateTimer = d.Get("aggregatetimer").(int)
+	settings.BgpTimers = bgpTimers{
+		KeepAlive:   d.Get("keepalive").(int),
+		HoldTime:    d.Get("holdtime").(int),
+		MinHoldTime: d.Get("minholdtime").(int),
+		Type:        "bgptimers",
+	}
+	settings.LogNeighborChanges = d.Get("logneighborchanges").(bool)
+	settings.FastExternalFallOver = d.Get("fastexternalfallover").(bool)
+	settings.Type = d.Get("type").(string)
+
+	return settings
+}
@@ -0,0 +1,181 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDeviceSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceDeviceSettingsRead,
+		CreateContext: resourceDeviceSettingsCreate,
+		UpdateContext: resourceDeviceSettingsUpdate,
+		DeleteContext: resourceDeviceSettingsDelete,
+		Description:   "Device hostname, CLI banners, console timeout and cloud services. Create will import device settings",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"loginbanner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Shown before login on SSH and console",
+			},
+			"motdbanner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Message of the day shown after login",
+			},
+			"consoletimeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Idle minutes, from 0 to 1440, before console sessions are closed. 0 never times out",
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"webanalytics": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Send anonymous FDM usage data to Cisco",
+			},
+			"successnetwork": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enroll in Cisco Success Network. Requires cloud services registration",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceDeviceSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	hostname, err := getDeviceHostname(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("id", hostname.ID)
+	d.Set("hostname", hostname.Hostname)
+
+	banners, err := getBannerSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("loginbanner", banners.LoginBanner)
+	d.Set("motdbanner", banners.MotdBanner)
+
+	timeout, err := getConsoleTimeout(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("consoletimeout", timeout.ConsoleTimeout)
+
+	webAnalytics, err := getWebAnalyticsSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("webanalytics", webAnalytics.EnableWebAnalytics)
+
+	successNetwork, err := getSuccessNetworkSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("successnetwork", successNetwork.EnableSuccessNetwork)
+
+	return diags
+}
+
+func resourceDeviceSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	hostname, err := getDeviceHostname(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(hostname.ID)
+
+	return resourceDeviceSettingsUpdate(ctx, d, m)
+}
+
+func resourceDeviceSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	// singletons are updated in place, current versions are taken from the device
+	hostname, err := getDeviceHostname(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hostname.Hostname = d.Get("hostname").(string)
+	if _, err := updateDeviceHostname(c, *hostname); err != nil {
+		return diag.FromErr(err)
+	}
+
+	banners, err := getBannerSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	banners.LoginBanner = d.Get("loginbanner").(string)
+	banners.MotdBanner = d.Get("motdbanner").(string)
+	if _, err := updateBannerSettings(c, *banners); err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout, err := getConsoleTimeout(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	timeout.ConsoleTimeout = d.Get("consoletimeout").(int)
+	if _, err := updateConsoleTimeout(c, *timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	webAnalytics, err := getWebAnalyticsSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	webAnalytics.EnableWebAnalytics = d.Get("webanalytics").(bool)
+	if _, err := updateWebAnalyticsSettings(c, *webAnalytics); err != nil {
+		return diag.FromErr(err)
+	}
+
+	successNetwork, err := getSuccessNetworkSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	successNetwork.EnableSuccessNetwork = d.Get("successnetwork").(bool)
+	if _, err := updateSuccessNetworkSettings(c, *successNetwork); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceDeviceSettingsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDeviceSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Device settings can not be deleted",
+		Detail:   "Device settings can not be deleted. Just updated.",
+	})
+
+	return diags
+}
@@ -0,0 +1,321 @@
+package ftd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+func resourceDHCPServer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceDHCPServerRead,
+		CreateContext: resourceDHCPServerCreate,
+		UpdateContext: resourceDHCPServerUpdate,
+		DeleteContext: resourceDHCPServerDelete,
+		Description:   "DHCP server pools on data interfaces. Create will import device DHCP server settings, destroy removes all pools",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"autoconfig": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Take DNS and WINS options from the DHCP client running on autoconfiginterface",
+			},
+			"autoconfiginterface": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Interface, usually outside, with a DHCP client from which auto configuration is taken",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "physicalinterface",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"primarydns": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secondarydns": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"primarywins": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secondarywins": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Address pools, one per interface. The pool must be inside the interface ipv4 subnet",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interface": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "physicalinterface",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"enabledhcp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"addresspoolstart": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"addresspoolend": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "dhcpserver",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "dhcpservercontainer",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceDHCPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	container, err := getDHCPServerContainer(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("id", container.ID)
+	d.Set("version", container.Version)
+	d.Set("autoconfig", container.AutoConfig)
+
+	if err := d.Set("autoconfiginterface", flattenReference(container.Interface)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("primarydns", container.PrimaryDNS)
+	d.Set("secondarydns", container.SecondaryDNS)
+	d.Set("primarywins", container.PrimaryWINS)
+	d.Set("secondarywins", container.SecondaryWINS)
+
+	servers := make([]interface{}, len(container.Servers))
+	for i, server := range container.Servers {
+		pool := strings.SplitN(server.AddressPool, "-", 2)
+		s := map[string]interface{}{
+			"interface":        flattenReference(&server.Interface),
+			"enabledhcp":       server.EnableDHCP,
+			"addresspoolstart": pool[0],
+			"addresspoolend":   pool[len(pool)-1],
+			"type":             server.Type,
+		}
+		servers[i] = s
+	}
+	if err := d.Set("servers", servers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", container.Type)
+
+	return diags
+}
+
+func resourceDHCPServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	container, err := getDHCPServerContainer(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(container.ID)
+	d.Set("version", container.Version)
+
+	return resourceDHCPServerUpdate(ctx, d, m)
+}
+
+func resourceDHCPServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var container dhcpServerContainer
+
+	container.ID = d.Get("id").(string)
+	container.Version = d.Get("version").(string)
+	container.AutoConfig = d.Get("autoconfig").(bool)
+	container.Interface = restoreReference(d.Get("autoconfiginterface"))
+	container.PrimaryDNS = d.Get("primarydns").(string)
+	container.SecondaryDNS = d.Get("secondarydns").(string)
+	container.PrimaryWINS = d.Get("primarywins").(string)
+	container.SecondaryWINS = d.Get("secondarywins").(string)
+	container.Servers = []dhcpServer{}
+	container.Type = d.Get("type").(string)
+
+	for _, server := range d.Get("servers").([]interface{}) {
+		s := server.(map[string]interface{})
+
+		iface := returnFirstIfExists(restoreReferenceObject(s["interface"]))
+		start := s["addresspoolstart"].(string)
+		end := s["addresspoolend"].(string)
+
+		if err := validateDHCPPool(c, iface.ID, start, end); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid DHCP address pool",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		container.Servers = append(container.Servers, dhcpServer{
+			EnableDHCP:  s["enabledhcp"].(bool),
+			AddressPool: fmt.Sprintf("%s-%s", start, end),
+			Interface:   iface,
+			Type:        s["type"].(string),
+		})
+	}
+
+	_, err := updateDHCPServerContainer(c, container)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceDHCPServerRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDHCPServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	// the container itself can not be deleted, drop pools and auto configuration instead
+	container, err := getDHCPServerContainer(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	container.AutoConfig = false
+	container.Interface = nil
+	container.PrimaryDNS = ""
+	container.SecondaryDNS = ""
+	container.PrimaryWINS = ""
+	container.SecondaryWINS = ""
+	container.Servers = []dhcpServer{}
+
+	_, err = updateDHCPServerContainer(c, *container)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// validateDHCPPool checks the pool against the static ipv4 address of the interface it is served on
+func validateDHCPPool(c *ftdc.Client, interfaceID string, start string, end string) error {
+	iface, err := c.GetNetworkInterface(interfaceID)
+	if err != nil {
+		return err
+	}
+
+	address := net.ParseIP(iface.Ipv4.IpAddress.IpAddress).To4()
+	if address == nil {
+		return fmt.Errorf("interface %s has no static ipv4 address", iface.Name)
+	}
+
+	mask, err := parseNetmask(iface.Ipv4.IpAddress.Netmask)
+	if err != nil {
+		return fmt.Errorf("interface %s: %s", iface.Name, err)
+	}
+
+	subnet := net.IPNet{IP: address.Mask(mask), Mask: mask}
+
+	startIP := net.ParseIP(start).To4()
+	endIP := net.ParseIP(end).To4()
+	if startIP == nil || endIP == nil {
+		return fmt.Errorf("pool %s-%s is not a valid ipv4 range", start, end)
+	}
+	if !subnet.Contains(startIP) || !subnet.Contains(endIP) {
+		return fmt.Errorf("pool %s-%s is outside of interface %s subnet %s", start, end, iface.Name, subnet.String())
+	}
+	if bytes.Compare(startIP, endIP) > 0 {
+		return fmt.Errorf("pool start %s is greater than pool end %s", start, end)
+	}
+	if bytes.Compare(startIP, address) <= 0 && bytes.Compare(address, endIP) <= 0 {
+		return fmt.Errorf("pool %s-%s contains interface %s address %s", start, end, iface.Name, address)
+	}
+
+	return nil
+}
+
+// parseNetmask accepts both dotted (255.255.255.0) and prefix length (24) netmasks
+func parseNetmask(netmask string) (net.IPMask, error) {
+	if prefix, err := strconv.Atoi(netmask); err == nil && prefix >= 0 && prefix <= 32 {
+		return net.CIDRMask(prefix, 32), nil
+	}
+
+	ip := net.ParseIP(netmask).To4()
+	if ip == nil {
+		return nil, fmt.Errorf("invalid netmask %s", netmask)
+	}
+	return net.IPMask(ip), nil
+}
@@ -0,0 +1,186 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSServerGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceDNSServerGroupRead,
+		CreateContext: resourceDNSServerGroupCreate,
+		UpdateContext: resourceDNSServerGroupUpdate,
+		DeleteContext: resourceDNSServerGroupDelete,
+		Description:   "DNS server group used by management and data interfaces. Required to resolve FQDN network objects",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A string containing the name of the object, up to 48 characters in length",
+			},
+			"dnsservers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    6,
+				Description: "An ordered list of DNS servers. Servers are queried in the order they are listed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipaddress": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "dnsserver",
+						},
+					},
+				},
+			},
+			"searchdomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Domain name appended to hostnames that are not fully qualified",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "Number of seconds, from 1 to 30, to wait before trying the next DNS server",
+				ValidateFunc: validation.IntBetween(1, 30),
+			},
+			"retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "Number of times, from 0 to 10, to retry the list of DNS servers",
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"systemdefined": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "dnsservergroup",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceDNSServerGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	group, err := getDNSServerGroup(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", group.ID)
+	d.Set("version", group.Version)
+	d.Set("name", group.Name)
+
+	servers := make([]interface{}, len(group.DnsServers))
+	for i, server := range group.DnsServers {
+		servers[i] = map[string]interface{}{
+			"ipaddress": server.IpAddress,
+			"type":      server.Type,
+		}
+	}
+	if err := d.Set("dnsservers", servers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("searchdomain", group.SearchDomain)
+	d.Set("timeout", group.Timeout)
+	d.Set("retries", group.Retries)
+	d.Set("systemdefined", group.SystemDefined)
+	d.Set("type", group.Type)
+
+	return diags
+}
+
+func resourceDNSServerGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	group, err := createDNSServerGroup(c, createDNSServerGroupModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group.ID)
+	resourceDNSServerGroupRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDNSServerGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateDNSServerGroup(c, createDNSServerGroupModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceDNSServerGroupRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDNSServerGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var group dnsServerGroup
+	group.ID = d.Get("id").(string)
+
+	err := deleteDNSServerGroup(c, group)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createDNSServerGroupModel(d *schema.ResourceData) dnsServerGroup {
+	var group dnsServerGroup
+
+	group.ID = d.Get("id").(string)
+	group.Version = d.Get("version").(string)
+	group.Name = d.Get("name").(string)
+
+	for _, server := range d.Get("dnsservers").([]interface{}) {
+		s := server.(map[string]interface{})
+		group.DnsServers = append(group.DnsServers, dnsServer{
+			IpAddress: s["ipaddress"].(string),
+			Type:      s["type"].(string),
+		})
+	}
+
+	group.SearchDomain = d.Get("searchdomain").(string)
+	group.Timeout = d.Get("timeout").(int)
+	group.Retries = d.Get("retries").(int)
+	group.Type = d.Get("type").(string)
+
+	return group
+}
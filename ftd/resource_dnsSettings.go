@@ -0,0 +1,247 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+func resourceDNSSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceDNSSettingsRead,
+		CreateContext: resourceDNSSettingsCreate,
+		UpdateContext: resourceDNSSettingsUpdate,
+		DeleteContext: resourceDNSSettingsDelete,
+		Description:   "Binds DNS server groups to the management and data interfaces. Create will import device DNS settings",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managementdnssettingsid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managementdnsservergroup": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "DNS server group used by the management interface",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "dnsservergroup",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"datadnsservergroup": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "DNS server group used by data interfaces. Needed to resolve FQDN network objects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "dnsservergroup",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"datainterfaces": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Data interfaces used for DNS lookups. Lookups use all data interfaces if empty",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "physicalinterface",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"fqdndnssettings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Resolution timers for FQDN network objects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"polltimer": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      240,
+							Description:  "Minutes, from 1 to 65535, between FQDN lookups",
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"expiryentrytimer": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Minutes, from 1 to 65535, after which an unresolved FQDN entry is removed",
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "fqdndnssettings",
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceDNSSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	mgmt, err := getDeviceDNSSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data, err := getDataDNSSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("id", data.ID)
+	d.Set("managementdnssettingsid", mgmt.ID)
+
+	if err := d.Set("managementdnsservergroup", flattenReference(mgmt.DnsServerGroup)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("datadnsservergroup", flattenReference(data.DnsServerGroup)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("datainterfaces", flattenReferenceModel(&data.Interfaces)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if data.FqdnDNSSettings != nil {
+		fqdn := map[string]interface{}{
+			"polltimer":        data.FqdnDNSSettings.PollTimer,
+			"expiryentrytimer": data.FqdnDNSSettings.ExpiryEntryTimer,
+			"type":             data.FqdnDNSSettings.Type,
+		}
+		if err := d.Set("fqdndnssettings", []interface{}{fqdn}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceDNSSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	data, err := getDataDNSSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(data.ID)
+
+	return resourceDNSSettingsUpdate(ctx, d, m)
+}
+
+func resourceDNSSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	// singletons are updated in place, current versions are taken from the device
+	mgmt, err := getDeviceDNSSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mgmt.DnsServerGroup = restoreReference(d.Get("managementdnsservergroup"))
+
+	_, err = updateDeviceDNSSettings(c, *mgmt)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data, err := getDataDNSSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.DnsServerGroup = restoreReference(d.Get("datadnsservergroup"))
+	data.Interfaces = restoreReferenceObjectSet(d.Get("datainterfaces"))
+	if data.Interfaces == nil {
+		data.Interfaces = []ftdc.ReferenceModel{}
+	}
+
+	fqdnSettings := d.Get("fqdndnssettings").([]interface{})
+	for _, fqdnSetting := range fqdnSettings {
+		f := fqdnSetting.(map[string]interface{})
+		data.FqdnDNSSettings = &fqdnDNSSettings{
+			PollTimer:        f["polltimer"].(int),
+			ExpiryEntryTimer: f["expiryentrytimer"].(int),
+			Type:             f["type"].(string),
+		}
+	}
+
+	_, err = updateDataDNSSettings(c, *data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceDNSSettingsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDNSSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "DNS settings can not be deleted",
+		Detail:   "DNS settings can not be deleted. Just updated.",
+	})
+
+	return diags
+}
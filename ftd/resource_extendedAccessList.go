@@ -0,0 +1,253 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceExtendedAccessList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceExtendedAccessListRead,
+		CreateContext: resourceExtendedAccessListCreate,
+		UpdateContext: resourceExtendedAccessListUpdate,
+		DeleteContext: resourceExtendedAccessListDelete,
+		Description:   "Extended access list matching source and destination networks and ports. Used by route maps",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Entries evaluated in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "[PERMIT, DENY]",
+							ValidateFunc: validation.StringInSlice([]string{"PERMIT", "DENY"}, false),
+						},
+						"sourcenetworks": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Empty matches any",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "networkobject",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"destinationnetworks": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Empty matches any",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "networkobject",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"sourceports": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Empty matches any",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "tcpportobject",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"destinationports": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Empty matches any",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "tcpportobject",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "extendedaccesslistentry",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "extendedaccesslist",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceExtendedAccessListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	accessList, err := getExtendedAccessList(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", accessList.ID)
+	d.Set("version", accessList.Version)
+	d.Set("name", accessList.Name)
+
+	entries := make([]interface{}, len(accessList.Entries))
+	for i, entry := range accessList.Entries {
+		entries[i] = map[string]interface{}{
+			"action":              entry.Action,
+			"sourcenetworks":      flattenReferenceModel(&entry.SourceNetworks),
+			"destinationnetworks": flattenReferenceModel(&entry.DestinationNetworks),
+			"sourceports":         flattenReferenceModel(&entry.SourcePorts),
+			"destinationports":    flattenReferenceModel(&entry.DestinationPorts),
+			"type":                entry.Type,
+		}
+	}
+	if err := d.Set("entries", entries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", accessList.Type)
+
+	return diags
+}
+
+func resourceExtendedAccessListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	accessList, err := createExtendedAccessList(c, createExtendedAccessListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(accessList.ID)
+	resourceExtendedAccessListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceExtendedAccessListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateExtendedAccessList(c, createExtendedAccessListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceExtendedAccessListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceExtendedAccessListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var accessList extendedAccessList
+	accessList.ID = d.Get("id").(string)
+
+	err := deleteExtendedAccessList(c, accessList)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createExtendedAccessListModel(d *schema.ResourceData) extendedAccessList {
+	var accessList extendedAccessList
+
+	accessList.ID = d.Get("id").(string)
+	accessList.Version = d.Get("version").(string)
+	accessList.Name = d.Get("name").(string)
+
+	accessList.Entries = []extendedAccessListEntry{}
+	for _, entry := range d.Get("entries").([]interface{}) {
+		e := entry.(map[string]interface{})
+		accessList.Entries = append(accessList.Entries, extendedAccessListEntry{
+			Action:              e["action"].(string),
+			SourceNetworks:      restoreReferenceObjectSet(e["sourcenetworks"]),
+			DestinationNetworks: restoreReferenceObjectSet(e["destinationnetworks"]),
+			SourcePorts:         restoreReferenceObjectSet(e["sourceports"]),
+			DestinationPorts:    restoreReferenceObjectSet(e["destinationports"]),
+			Type:                e["type"].(string),
+		})
+	}
+
+	accessList.Type = d.Get("type").(string)
+
+	return accessList
+}
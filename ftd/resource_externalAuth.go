@@ -0,0 +1,159 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceExternalAuth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceExternalAuthRead,
+		CreateContext: resourceExternalAuthCreate,
+		UpdateContext: resourceExternalAuthUpdate,
+		DeleteContext: resourceExternalAuthDelete,
+		Description:   "RADIUS or Active Directory authentication of HTTPS (FDM) or SSH admins. Create will import the protocol AAA settings, destroy restores local authentication",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "[HTTPS, SSH]",
+				ValidateFunc: validation.StringInSlice([]string{"HTTPS", "SSH"}, false),
+			},
+			"servergroup": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "RADIUS server group or AD realm (activedirectoryrealm) admins are authenticated against",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "radiusidentitysourcegroup",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"uselocal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AFTER_EXTERNAL",
+				Description:  "When local users are tried. [BEFORE_EXTERNAL, AFTER_EXTERNAL, NEVER]",
+				ValidateFunc: validation.StringInSlice([]string{"BEFORE_EXTERNAL", "AFTER_EXTERNAL", "NEVER"}, false),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aaasetting",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceExternalAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	setting, err := getAAASetting(c, d.Get("id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("id", setting.ID)
+	d.Set("version", setting.Version)
+	d.Set("protocol", setting.ProtocolType)
+
+	if err := d.Set("servergroup", flattenReference(setting.AuthGroup)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("uselocal", setting.UseLocal)
+	d.Set("type", setting.Type)
+
+	return diags
+}
+
+func resourceExternalAuthCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	setting, err := getAAASettingByProtocol(c, d.Get("protocol").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(setting.ID)
+	d.Set("version", setting.Version)
+
+	return resourceExternalAuthUpdate(ctx, d, m)
+}
+
+func resourceExternalAuthUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var setting aaaSetting
+	setting.ID = d.Get("id").(string)
+	setting.Version = d.Get("version").(string)
+	setting.ProtocolType = d.Get("protocol").(string)
+	setting.AuthGroup = restoreReference(d.Get("servergroup"))
+	setting.UseLocal = d.Get("uselocal").(string)
+	setting.Type = d.Get("type").(string)
+
+	_, err := updateAAASetting(c, setting)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceExternalAuthRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceExternalAuthDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	setting, err := getAAASetting(c, d.Get("id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	local, err := getLocalIdentitySource(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setting.AuthGroup = local
+	setting.UseLocal = ""
+
+	_, err = updateAAASetting(c, *setting)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
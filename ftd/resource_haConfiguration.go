@@ -0,0 +1,429 @@
+package ftd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// haSyncPollInterval is how often HA status is checked while waiting for the pair to sync
+const haSyncPollInterval = 10 * time.Second
+
+func resourceHAConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceHAConfigurationRead,
+		CreateContext: resourceHAConfigurationCreate,
+		UpdateContext: resourceHAConfigurationUpdate,
+		DeleteContext: resourceHAConfigurationDelete,
+		Description:   "Active/standby high availability pair settings. Create will import device HA configuration. Interfaces with monitorinterface set in ftd_interface are health checked by failovercriteria",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"noderole": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Role of this device in the pair. [HA_PRIMARY, HA_SECONDARY]",
+				ValidateFunc: validation.StringInSlice([]string{"HA_PRIMARY", "HA_SECONDARY"}, false),
+			},
+			"failoverinterface": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Unnamed interface used for the failover link",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "physicalinterface",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"failovername": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"primaryfailoveripv4": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"secondaryfailoveripv4": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"failovernetmask": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Netmask of the failover link. 255.255.255.252 or 30",
+			},
+			"statefulfailoverinterface": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Interface used for the stateful failover link. Can be the failover interface",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "physicalinterface",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"statefulfailovername": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"statefulfailoverinterface"},
+			},
+			"primarystatefulfailoveripv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"statefulfailoverinterface"},
+			},
+			"secondarystatefulfailoveripv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"statefulfailoverinterface"},
+			},
+			"statefulfailovernetmask": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"statefulfailoverinterface"},
+			},
+			"sharedkey": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Key encrypting failover link traffic, must match on both units. Not part of the HA configuration FDM returns",
+			},
+			"failovercriteriaid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"interfacefailurethreshold": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Failed monitored interfaces, in interfacefailureunit, that trigger a failover",
+			},
+			"interfacefailureunit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NUMBER",
+				Description:  "[NUMBER, PERCENTAGE]",
+				ValidateFunc: validation.StringInSlice([]string{"NUMBER", "PERCENTAGE"}, false),
+			},
+			"interfacepolltime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				Description:  "Seconds between monitored interface polls",
+				ValidateFunc: validation.IntBetween(1, 15),
+			},
+			"interfaceholdtime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      25,
+				Description:  "Seconds without hello on a monitored interface before it is marked failed",
+				ValidateFunc: validation.IntBetween(5, 75),
+			},
+			"peerpolltime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "Seconds between hello messages on the failover link",
+				ValidateFunc: validation.IntBetween(1, 15),
+			},
+			"peerholdtime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				Description:  "Seconds without hello from the peer before it is marked failed",
+				ValidateFunc: validation.IntBetween(3, 45),
+			},
+			"monitoredinterfaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Interfaces with monitorinterface set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"waitforsync": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait until the pair reports configuration in sync after apply. Configure the peer first, otherwise apply waits until the timeout. Set to false while the peer is not set up yet",
+			},
+			"nodestate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peernodestate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configstatus": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"healthstatus": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "haconfiguration",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceHAConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	ha, err := getHAConfiguration(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("id", ha.ID)
+	d.Set("version", ha.Version)
+	d.Set("noderole", ha.NodeRole)
+	d.Set("failoverinterface", flattenReference(ha.FailoverInterface))
+	d.Set("failovername", ha.FailoverName)
+	if ha.PrimaryFailoverIPv4 != nil {
+		d.Set("primaryfailoveripv4", ha.PrimaryFailoverIPv4.IpAddress)
+		d.Set("failovernetmask", ha.PrimaryFailoverIPv4.Netmask)
+	}
+	if ha.SecondaryFailoverIPv4 != nil {
+		d.Set("secondaryfailoveripv4", ha.SecondaryFailoverIPv4.IpAddress)
+	}
+	d.Set("statefulfailoverinterface", flattenReference(ha.StatefulFailoverInterface))
+	d.Set("statefulfailovername", ha.StatefulFailoverName)
+	if ha.PrimaryStatefulFailoverIPv4 != nil {
+		d.Set("primarystatefulfailoveripv4", ha.PrimaryStatefulFailoverIPv4.IpAddress)
+		d.Set("statefulfailovernetmask", ha.PrimaryStatefulFailoverIPv4.Netmask)
+	}
+	if ha.SecondaryStatefulFailoverIPv4 != nil {
+		d.Set("secondarystatefulfailoveripv4", ha.SecondaryStatefulFailoverIPv4.IpAddress)
+	}
+	d.Set("type", ha.Type)
+
+	criteria, err := getHAFailoverCriteria(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("failovercriteriaid", criteria.ID)
+	d.Set("interfacefailurethreshold", criteria.InterfaceFailureThreshold)
+	d.Set("interfacefailureunit", criteria.InterfaceFailureUnit)
+	d.Set("interfacepolltime", criteria.InterfacePollTime)
+	d.Set("interfaceholdtime", criteria.InterfaceHoldTime)
+	d.Set("peerpolltime", criteria.PeerPollTime)
+	d.Set("peerholdtime", criteria.PeerHoldTime)
+
+	monitored, err := getMonitoredInterfaces(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	monitoredInterfaces := make([]interface{}, len(monitored))
+	for i, iface := range monitored {
+		monitoredInterfaces[i] = map[string]interface{}{
+			"id":   iface.ID,
+			"name": iface.Name,
+		}
+	}
+	if err := d.Set("monitoredinterfaces", monitoredInterfaces); err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, err := getHAStatus(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("nodestate", status.NodeState)
+	d.Set("peernodestate", status.PeerNodeState)
+	d.Set("configstatus", status.ConfigStatus)
+	d.Set("healthstatus", status.HaHealthStatus)
+
+	return diags
+}
+
+func resourceHAConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	ha, err := getHAConfiguration(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ha.ID)
+
+	return updateHA(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+}
+
+func resourceHAConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateHA(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+}
+
+func resourceHAConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "HA configuration can not be deleted",
+		Detail:   "HA configuration can not be deleted. Break the HA pair from FDM.",
+	})
+
+	return diags
+}
+
+func updateHA(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	// singletons are updated in place, current versions are taken from the device
+	ha, err := getHAConfiguration(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ha.NodeRole = d.Get("noderole").(string)
+	ha.FailoverInterface = restoreReference(d.Get("failoverinterface"))
+	ha.FailoverName = d.Get("failovername").(string)
+	ha.PrimaryFailoverIPv4 = newHAIPv4Address(d.Get("primaryfailoveripv4").(string), d.Get("failovernetmask").(string))
+	ha.SecondaryFailoverIPv4 = newHAIPv4Address(d.Get("secondaryfailoveripv4").(string), d.Get("failovernetmask").(string))
+	ha.StatefulFailoverInterface = restoreReference(d.Get("statefulfailoverinterface"))
+	ha.StatefulFailoverName = d.Get("statefulfailovername").(string)
+	ha.PrimaryStatefulFailoverIPv4 = newHAIPv4Address(d.Get("primarystatefulfailoveripv4").(string), d.Get("statefulfailovernetmask").(string))
+	ha.SecondaryStatefulFailoverIPv4 = newHAIPv4Address(d.Get("secondarystatefulfailoveripv4").(string), d.Get("statefulfailovernetmask").(string))
+	ha.SharedKey = d.Get("sharedkey").(string)
+	ha.Type = d.Get("type").(string)
+
+	_, err = updateHAConfiguration(c, *ha)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	criteria, err := getHAFailoverCriteria(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	criteria.InterfaceFailureThreshold = d.Get("interfacefailurethreshold").(int)
+	criteria.InterfaceFailureUnit = d.Get("interfacefailureunit").(string)
+	criteria.InterfacePollTime = d.Get("interfacepolltime").(int)
+	criteria.InterfacePollTimeUnit = "SEC"
+	criteria.InterfaceHoldTime = d.Get("interfaceholdtime").(int)
+	criteria.PeerPollTime = d.Get("peerpolltime").(int)
+	criteria.PeerPollTimeUnit = "SEC"
+	criteria.PeerHoldTime = d.Get("peerholdtime").(int)
+	criteria.PeerHoldTimeUnit = "SEC"
+
+	_, err = updateHAFailoverCriteria(c, *criteria)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("waitforsync").(bool) {
+		if err := waitForHASync(ctx, c, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceHAConfigurationRead(ctx, d, m)
+}
+
+// waitForHASync polls HA status until both nodes are up and report the configuration in sync
+func waitForHASync(ctx context.Context, c *ftdc.Client, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(haSyncPollInterval)
+	defer ticker.Stop()
+
+	var status *haStatus
+	for {
+		var err error
+		status, err = getHAStatus(c)
+		if err != nil {
+			return err
+		}
+
+		if status.ConfigStatus == "IN_SYNC" && isHANodeReady(status.NodeState) && isHANodeReady(status.PeerNodeState) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("HA pair not in sync after %s: node %s, peer %s, config %s", timeout, status.NodeState, status.PeerNodeState, status.ConfigStatus)
+		case <-ticker.C:
+		}
+	}
+}
+
+func isHANodeReady(state string) bool {
+	return state == "HA_ACTIVE_NODE" || state == "HA_STANDBY_NODE"
+}
+
+func newHAIPv4Address(ipAddress string, netmask string) *haIPv4Address {
+	if ipAddress == "" {
+		return nil
+	}
+	return &haIPv4Address{
+		IpAddress: ipAddress,
+		Netmask:   netmask,
+		Type:      "ipv4address",
+	}
+}
@@ -0,0 +1,159 @@
+package ftd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInternalCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceInternalCertificateRead,
+		CreateContext: resourceInternalCertificateCreate,
+		UpdateContext: resourceInternalCertificateUpdate,
+		DeleteContext: resourceInternalCertificateDelete,
+		Description:   "Device identity certificate uploaded as PEM. Used by the FDM web UI, RA VPN and SSL decryption. Changing cert and privatekey rotates it in place",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cert": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "PEM encoded certificate, optionally followed by intermediates",
+				DiffSuppressFunc: suppressPEMDiff,
+			},
+			"privatekey": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key matching cert. Key material is upload only, FDM returns the certificate without it",
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validfrom": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validto": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "internalcertificate",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceInternalCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	cert, err := getCertificate(c, d.Get("id").(string), d.Get("type").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", cert.ID)
+	d.Set("version", cert.Version)
+	d.Set("name", cert.Name)
+	d.Set("cert", cert.Cert)
+	d.Set("subject", cert.SubjectCommonName)
+	d.Set("issuer", cert.IssuerCommonName)
+	d.Set("validfrom", cert.ValidityStartDate)
+	d.Set("validto", cert.ValidityEndDate)
+	d.Set("type", cert.Type)
+
+	return diags
+}
+
+func resourceInternalCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	cert, err := createCertificate(c, createInternalCertificateModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(cert.ID)
+	resourceInternalCertificateRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceInternalCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateCertificate(c, createInternalCertificateModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceInternalCertificateRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceInternalCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var cert certificate
+	cert.ID = d.Get("id").(string)
+	cert.Type = d.Get("type").(string)
+
+	err := deleteCertificate(c, cert)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createInternalCertificateModel(d *schema.ResourceData) certificate {
+	var cert certificate
+
+	cert.ID = d.Get("id").(string)
+	cert.Version = d.Get("version").(string)
+	cert.Name = d.Get("name").(string)
+	cert.Cert = d.Get("cert").(string)
+	cert.PrivateKey = d.Get("privatekey").(string)
+	cert.CertType = "UPLOAD"
+	cert.Type = d.Get("type").(string)
+
+	return cert
+}
+
+// suppressPEMDiff ignores line ending and surrounding whitespace changes FDM makes to uploaded PEM
+func suppressPEMDiff(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(pem string) string {
+		return strings.TrimSpace(strings.ReplaceAll(pem, "\r\n", "\n"))
+	}
+	return normalize(old) == normalize(new)
+}
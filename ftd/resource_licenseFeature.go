@@ -0,0 +1,107 @@
+package ftd
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLicenseFeature() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceLicenseFeatureRead,
+		CreateContext: resourceLicenseFeatureCreate,
+		DeleteContext: resourceLicenseFeatureDelete,
+		Description:   "Optional feature license. THREAT is needed for intrusion and security intelligence, URLFILTERING for URL categories, PLUS, APEX or VPNONLY for remote access VPN",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"licensetype": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "[THREAT, MALWARE, URLFILTERING, PLUS, APEX, VPNONLY]",
+				ValidateFunc: validation.StringInSlice(licenseTypes, false),
+			},
+			"compliant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "license",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceLicenseFeatureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	l, err := getLicense(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", l.ID)
+	d.Set("version", l.Version)
+	d.Set("licensetype", l.LicenseType)
+	d.Set("compliant", l.Compliant)
+	d.Set("type", l.Type)
+
+	return diags
+}
+
+func resourceLicenseFeatureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var l license
+	l.Count = 1
+	l.LicenseType = d.Get("licensetype").(string)
+	l.Type = d.Get("type").(string)
+
+	created, err := createLicense(c, l)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(created.ID)
+	resourceLicenseFeatureRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceLicenseFeatureDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var l license
+	l.ID = d.Get("id").(string)
+
+	err := deleteLicense(c, l)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
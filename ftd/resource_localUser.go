@@ -0,0 +1,161 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLocalUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceLocalUserRead,
+		CreateContext: resourceLocalUserCreate,
+		UpdateContext: resourceLocalUserUpdate,
+		DeleteContext: resourceLocalUserDelete,
+		Description:   "Local FDM and CLI user",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Login password. Sent on create and on changes, bump passwordversion to set it again after it was changed on the device",
+			},
+			"passwordversion": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value. Changing it sets password on the device even if password did not change",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ROLE_READ_ONLY",
+				Description:  "[ROLE_ADMIN, ROLE_READ_WRITE, ROLE_READ_ONLY]",
+				ValidateFunc: validation.StringInSlice([]string{"ROLE_ADMIN", "ROLE_READ_WRITE", "ROLE_READ_ONLY"}, false),
+			},
+			"servicetypes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Services the user may log in to, MCV for FDM and CLI. [MCV, RA_VPN]",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"MCV", "RA_VPN"}, false),
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "user",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceLocalUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	user, err := getLocalUser(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", user.ID)
+	d.Set("version", user.Version)
+	d.Set("name", user.Name)
+	d.Set("role", user.UserRole)
+	if err := d.Set("servicetypes", user.UserServiceTypes); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("type", user.Type)
+
+	return diags
+}
+
+func resourceLocalUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	user := createLocalUserModel(d)
+	user.Password = d.Get("password").(string)
+
+	created, err := createLocalUser(c, user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(created.ID)
+	resourceLocalUserRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceLocalUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	// password is only sent when rotated, FDM keeps the current one otherwise
+	user := createLocalUserModel(d)
+	if d.HasChanges("password", "passwordversion") {
+		user.Password = d.Get("password").(string)
+	}
+
+	_, err := updateLocalUser(c, user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceLocalUserRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceLocalUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var user localUser
+	user.ID = d.Get("id").(string)
+
+	err := deleteLocalUser(c, user)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createLocalUserModel(d *schema.ResourceData) localUser {
+	var user localUser
+
+	user.ID = d.Get("id").(string)
+	user.Version = d.Get("version").(string)
+	user.Name = d.Get("name").(string)
+	user.UserRole = d.Get("role").(string)
+
+	for _, serviceType := range d.Get("servicetypes").(*schema.Set).List() {
+		user.UserServiceTypes = append(user.UserServiceTypes, serviceType.(string))
+	}
+
+	user.Type = d.Get("type").(string)
+
+	return user
+}
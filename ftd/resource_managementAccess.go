@@ -0,0 +1,211 @@
+package ftd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// system defined network objects which open management access to everyone
+var anyNetworkObjects = []string{"any-ipv4", "any-ipv6"}
+
+func resourceManagementAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceManagementAccessRead,
+		CreateContext: resourceManagementAccessCreate,
+		UpdateContext: resourceManagementAccessUpdate,
+		DeleteContext: resourceManagementAccessDelete,
+		Description:   "HTTPS (FDM) and SSH management access on a data interface",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"interface": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Data interface management access is allowed on",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "physicalinterface",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"protocols": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Allowed management protocols. [HTTPS, SSH]",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"HTTPS", "SSH"}, false),
+				},
+			},
+			"networkobjects": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Networks allowed to reach the management protocols",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "networkobject",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "managementaccess",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceManagementAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	access, err := getManagementAccess(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	// access opened to everyone outside of terraform is reported in addition to the plan diff
+	configured := restoreReferenceObjectSet(d.Get("networkobjects"))
+	for _, networkObject := range access.NetworkObjects {
+		if !isAnyNetworkObject(networkObject) || containsReference(configured, networkObject) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Management access is open to everyone",
+			Detail:   fmt.Sprintf("%v management access on interface %s allows %s, which is not part of the configuration.", access.Protocols, access.Interface.Name, networkObject.Name),
+		})
+	}
+
+	d.Set("id", access.ID)
+	d.Set("version", access.Version)
+
+	if err := d.Set("interface", flattenReference(&access.Interface)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("protocols", access.Protocols); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("networkobjects", flattenReferenceModel(&access.NetworkObjects)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", access.Type)
+
+	return diags
+}
+
+func resourceManagementAccessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	access, err := createManagementAccess(c, createManagementAccessModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(access.ID)
+	resourceManagementAccessRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceManagementAccessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateManagementAccess(c, createManagementAccessModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceManagementAccessRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceManagementAccessDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var access managementAccess
+	access.ID = d.Get("id").(string)
+
+	err := deleteManagementAccess(c, access)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createManagementAccessModel(d *schema.ResourceData) managementAccess {
+	var access managementAccess
+
+	access.ID = d.Get("id").(string)
+	access.Version = d.Get("version").(string)
+	access.Interface = returnFirstIfExists(restoreReferenceObject(d.Get("interface")))
+
+	for _, protocol := range d.Get("protocols").(*schema.Set).List() {
+		access.Protocols = append(access.Protocols, protocol.(string))
+	}
+
+	access.NetworkObjects = restoreReferenceObjectSet(d.Get("networkobjects"))
+	access.Type = d.Get("type").(string)
+
+	return access
+}
+
+func isAnyNetworkObject(networkObject ftdc.ReferenceModel) bool {
+	for _, name := range anyNetworkObjects {
+		if networkObject.Name == name {
+			return true
+		}
+	}
+	return false
+}
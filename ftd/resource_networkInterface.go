@@ -15,6 +15,7 @@ func resourceInterface() *schema.Resource {
 		UpdateContext: resourceInterfaceUpdate,
 		DeleteContext: resourceInterfaceDelete,
 		Description:   "Cisco FTD phisical interface shoud be imported for correct work",
+		Timeouts:      defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -41,7 +42,7 @@ func resourceInterface() *schema.Resource {
 			"monitorinterface": {
 				Type:        schema.TypeBool,
 				Required:    true,
-				Description: "A mandatory boolean object which specifies if the Interface needs to be monitored or not.",
+				Description: "A mandatory boolean object which specifies if the Interface needs to be monitored or not. Monitored interfaces are health checked by ftd_ha_configuration failover criteria.",
 			},
 			"ipv4": {
 				Type:     schema.TypeList,
@@ -202,7 +203,7 @@ func resourceInterface() *schema.Resource {
 }
 
 func resourceInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -220,7 +221,7 @@ func resourceInterfaceCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -267,7 +268,7 @@ func resourceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ftdc "github.com/mr-olenoid/ftd-client"
 )
 
@@ -17,6 +18,7 @@ func resourceNetworkObject() *schema.Resource {
 		CreateContext: resourceNetworkObjectCreate,
 		UpdateContext: resourceNetworkObjectUpdate,
 		DeleteContext: resourceNetworkObjectDelete,
+		Timeouts:      defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -58,8 +60,11 @@ func resourceNetworkObject() *schema.Resource {
 				Default:  "networkobject",
 			},
 			"dnsresolution": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "FQDN objects only. Address families resolved for the FQDN. Requires DNS configured with ftd_dns_settings. [IPV4_ONLY, IPV6_ONLY, IPV4_AND_IPV6]",
+				ValidateFunc: validation.StringInSlice([]string{"IPV4_ONLY", "IPV6_ONLY", "IPV4_AND_IPV6"}, false),
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -69,7 +74,7 @@ func resourceNetworkObject() *schema.Resource {
 }
 
 func resourceNetworkObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -78,7 +83,7 @@ func resourceNetworkObjectRead(ctx context.Context, d *schema.ResourceData, m in
 
 	networkObject, err := c.GetNetworkObject(networkObjectID)
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	d.Set("id", networkObject.ID)
@@ -88,13 +93,13 @@ func resourceNetworkObjectRead(ctx context.Context, d *schema.ResourceData, m in
 	d.Set("description", networkObject.Description)
 	d.Set("subtype", networkObject.SubType)
 	d.Set("type", networkObject.Type)
-	//d.Set("dnsresolution", networkObject.DnsResolution)
+	d.Set("dnsresolution", networkObject.DnsResolution)
 
 	return diags
 }
 
 func resourceNetworkObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -108,7 +113,9 @@ func resourceNetworkObjectCreate(ctx context.Context, d *schema.ResourceData, m
 	networkObject.Description = d.Get("description").(string)
 	networkObject.SubType = d.Get("subtype").(string)
 	networkObject.Type = d.Get("type").(string)
-	//networkObject = d.Get("dnsresolution").(string)
+	if networkObject.SubType == "FQDN" {
+		networkObject.DnsResolution = d.Get("dnsresolution").(string)
+	}
 
 	n, err := c.CreateNetworkObject(networkObject)
 	if err != nil {
@@ -123,7 +130,7 @@ func resourceNetworkObjectCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNetworkObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -137,7 +144,9 @@ func resourceNetworkObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 	networkObject.Description = d.Get("description").(string)
 	networkObject.SubType = d.Get("subtype").(string)
 	networkObject.Type = d.Get("type").(string)
-	//networkObject = d.Get("dnsresolution").(string)
+	if networkObject.SubType == "FQDN" {
+		networkObject.DnsResolution = d.Get("dnsresolution").(string)
+	}
 
 	n, err := c.UpdateNetworkObject(networkObject)
 	if err != nil {
@@ -152,7 +161,7 @@ func resourceNetworkObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNetworkObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -161,7 +170,7 @@ func resourceNetworkObjectDelete(ctx context.Context, d *schema.ResourceData, m
 
 	err := c.DeleteNetworkObject(networkObject)
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(err)
 	}
 
 	return diags
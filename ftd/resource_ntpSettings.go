@@ -0,0 +1,256 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// FDM factory defaults restored by reset_on_destroy
+var (
+	defaultNTPServers = []string{"0.sourcefire.pool.ntp.org", "1.sourcefire.pool.ntp.org", "2.sourcefire.pool.ntp.org"}
+	defaultTimeZone   = "UTC"
+)
+
+func resourceNTPSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceNTPSettingsRead,
+		CreateContext: resourceNTPSettingsCreate,
+		UpdateContext: resourceNTPSettingsUpdate,
+		DeleteContext: resourceNTPSettingsDelete,
+		Description:   "Device NTP and time zone settings. Create will import device NTP settings",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"ntpservers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "An ordered list of NTP servers, host names or IP addresses",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keyid": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Authentication key number, from 1 to 65535. Leave empty to use the server without authentication",
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"keytype": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "SHA1",
+							ValidateFunc: validation.StringInSlice([]string{"MD5", "SHA1", "SHA256"}, false),
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Authentication key value for keyid. The device only reports the key number, a value changed there is not picked up",
+						},
+					},
+				},
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time zone ID, for example UTC or Europe/Berlin",
+			},
+			"timezonesettingsid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reset_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restore FDM default NTP servers and UTC time zone on destroy instead of failing",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ntp",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNTPSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	ntp, err := getNTPSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tz, err := getTimeZoneSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("id", ntp.ID)
+	d.Set("version", ntp.Version)
+	d.Set("enabled", ntp.Enabled)
+
+	// ntpServerKeys carry keyid and keytype only, the key value comes from the matching server in state
+	keys := make(map[string]string)
+	for _, server := range d.Get("ntpservers").([]interface{}) {
+		s := server.(map[string]interface{})
+		keys[s["server"].(string)] = s["key"].(string)
+	}
+
+	servers := make([]interface{}, len(ntp.NtpServers))
+	for i, server := range ntp.NtpServers {
+		s := map[string]interface{}{
+			"server":  server,
+			"keyid":   0,
+			"keytype": "SHA1",
+			"key":     "",
+		}
+		for _, serverKey := range ntp.NtpServerKeys {
+			if serverKey.Server == server {
+				s["keyid"] = serverKey.KeyId
+				s["keytype"] = serverKey.KeyType
+				s["key"] = keys[server]
+			}
+		}
+		servers[i] = s
+	}
+	if err := d.Set("ntpservers", servers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("timezone", tz.TimeZoneId)
+	d.Set("timezonesettingsid", tz.ID)
+	d.Set("type", ntp.Type)
+
+	return diags
+}
+
+func resourceNTPSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	ntp, err := getNTPSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ntp.ID)
+	d.Set("version", ntp.Version)
+
+	return resourceNTPSettingsUpdate(ctx, d, m)
+}
+
+func resourceNTPSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var ntp ntpSettings
+
+	ntp.ID = d.Get("id").(string)
+	ntp.Version = d.Get("version").(string)
+	ntp.Enabled = d.Get("enabled").(bool)
+	ntp.Type = d.Get("type").(string)
+
+	for _, server := range d.Get("ntpservers").([]interface{}) {
+		s := server.(map[string]interface{})
+		ntp.NtpServers = append(ntp.NtpServers, s["server"].(string))
+		if s["keyid"].(int) > 0 {
+			ntp.NtpServerKeys = append(ntp.NtpServerKeys, ntpServerKey{
+				Server:   s["server"].(string),
+				KeyId:    s["keyid"].(int),
+				KeyType:  s["keytype"].(string),
+				KeyValue: s["key"].(string),
+				Type:     "ntpserverkey",
+			})
+		}
+	}
+
+	_, err := updateNTPSettings(c, ntp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if timeZone, ok := d.GetOk("timezone"); ok && d.HasChange("timezone") {
+		tz, err := getTimeZoneSettings(c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		tz.TimeZoneId = timeZone.(string)
+
+		_, err = updateTimeZoneSettings(c, *tz)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceNTPSettingsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceNTPSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("reset_on_destroy").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "NTP settings can not be deleted",
+			Detail:   "NTP settings can not be deleted. Just updated. Set reset_on_destroy to restore FDM defaults on destroy.",
+		})
+		return diags
+	}
+
+	c := clientFromMeta(ctx, d, m)
+
+	ntp, err := getNTPSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ntp.Enabled = true
+	ntp.NtpServers = defaultNTPServers
+	ntp.NtpServerKeys = nil
+
+	_, err = updateNTPSettings(c, *ntp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tz, err := getTimeZoneSettings(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tz.TimeZoneId = defaultTimeZone
+
+	_, err = updateTimeZoneSettings(c, *tz)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,611 @@
+package ftd
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// FDM redistribution types by provider protocol name and back
+var (
+	ospfRedistributeTypes = map[string]string{
+		"CONNECTED": "redistributeconnected",
+		"STATIC":    "redistributestatic",
+		"BGP":       "redistributebgp",
+		"OSPF":      "redistributeospf",
+	}
+	ospfRedistributeProtocols = map[string]string{
+		"redistributeconnected": "CONNECTED",
+		"redistributestatic":    "STATIC",
+		"redistributebgp":       "BGP",
+		"redistributeospf":      "OSPF",
+	}
+)
+
+func resourceOSPF() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceOSPFRead,
+		CreateContext: resourceOSPFCreate,
+		UpdateContext: resourceOSPFUpdate,
+		DeleteContext: resourceOSPFDelete,
+		Description:   "OSPFv2 routing process with its areas, interface settings and redistribution",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtualrouterid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Virtual router the process runs in. Global virtual router is used if empty",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"processid": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "OSPF process ID, from 1 to 65535",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"routerid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Router ID in IPv4 address format. Selected automatically if empty",
+			},
+			"areas": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"areaid": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Area ID as a number or in IPv4 address format",
+						},
+						"areatype": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "normalarea",
+							Description:  "[normalarea, stubarea, nssaarea]",
+							ValidateFunc: validation.StringInSlice([]string{"normalarea", "stubarea", "nssaarea"}, false),
+						},
+						"networks": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "Network objects advertised into the area (network statements)",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "networkobject",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "area",
+						},
+					},
+				},
+			},
+			"interfacesettings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Per interface OSPF settings",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "physicalinterface",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"cost": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Designated router election priority, 0 means the router never becomes DR",
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+						"hellointerval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"deadinterval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      40,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"authentication": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NONE",
+							Description:  "[NONE, PASSWORD, MD5]",
+							ValidateFunc: validation.StringInSlice([]string{"NONE", "PASSWORD", "MD5"}, false),
+						},
+						"authenticationkey": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Password or MD5 key. Interface settings read back from FDM come without it, so the configured key stays authoritative",
+						},
+						"md5keyid": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "MD5 key ID, from 1 to 255",
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+					},
+				},
+			},
+			"redistribute": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "[CONNECTED, STATIC, BGP, OSPF]",
+							ValidateFunc: validation.StringInSlice([]string{"CONNECTED", "STATIC", "BGP", "OSPF"}, false),
+						},
+						"metric": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"metrictype": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "TYPE_2",
+							ValidateFunc: validation.StringInSlice([]string{"TYPE_1", "TYPE_2"}, false),
+						},
+						"subnets": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"asnumber": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "BGP autonomous system, BGP only",
+						},
+						"processid": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Redistributed OSPF process ID, OSPF only",
+						},
+						"routemap": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Route map filtering redistributed routes",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "routemap",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"defaultinformationoriginate": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Originate a default route into the OSPF domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alwaysadvertise": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Advertise the default route even if the routing table has none",
+						},
+						"metric": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"metrictype": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "TYPE_2",
+							ValidateFunc: validation.StringInSlice([]string{"TYPE_1", "TYPE_2"}, false),
+						},
+						"routemap": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Originate the default route only when the route map matches",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "routemap",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ospf",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceOSPFRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	vrId, err := virtualRouterID(c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, err := getOSPF(c, vrId, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", o.ID)
+	d.Set("version", o.Version)
+	d.Set("virtualrouterid", vrId)
+	d.Set("name", o.Name)
+	if processId, err := strconv.Atoi(o.ProcessId); err == nil {
+		d.Set("processid", processId)
+	}
+	d.Set("routerid", o.ProcessConfiguration.RouterId)
+
+	areas := make([]interface{}, len(o.Areas))
+	for i, area := range o.Areas {
+		networks := make([]ftdc.ReferenceModel, len(area.AreaNetworks))
+		for j, network := range area.AreaNetworks {
+			networks[j] = network.Ipv4Network
+		}
+		areas[i] = map[string]interface{}{
+			"areaid":   area.AreaId,
+			"areatype": area.AreaType.Type,
+			"networks": flattenReferenceModel(&networks),
+			"type":     area.Type,
+		}
+	}
+	if err := d.Set("areas", areas); err != nil {
+		return diag.FromErr(err)
+	}
+
+	redistributes := make([]interface{}, len(o.RedistributeProtocols))
+	for i, redistribute := range o.RedistributeProtocols {
+		redistributes[i] = map[string]interface{}{
+			"protocol":   ospfRedistributeProtocols[redistribute.Type],
+			"metric":     redistribute.MetricValue,
+			"metrictype": redistribute.MetricTypeValue,
+			"subnets":    redistribute.Subnets,
+			"asnumber":   redistribute.AsNumber,
+			"processid":  redistribute.ProcessId,
+			"routemap":   flattenReference(redistribute.RouteMap),
+		}
+	}
+	if err := d.Set("redistribute", redistributes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var originate []interface{}
+	if dio := o.ProcessConfiguration.DefaultInformationOriginate; dio != nil {
+		originate = append(originate, map[string]interface{}{
+			"alwaysadvertise": dio.AlwaysAdvertise,
+			"metric":          dio.Metric,
+			"metrictype":      dio.MetricType,
+			"routemap":        flattenReference(dio.RouteMap),
+		})
+	}
+	if err := d.Set("defaultinformationoriginate", originate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// interface settings are separate objects, fetched by the IDs in state together with their keys
+	var interfaceSettings []interface{}
+	for _, setting := range d.Get("interfacesettings").([]interface{}) {
+		s := setting.(map[string]interface{})
+		if s["id"].(string) == "" {
+			continue
+		}
+
+		// settings deleted on the device are left out, so they are planned for creation again
+		current, err := getOSPFInterfaceSettings(c, vrId, s["id"].(string))
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		config := current.OspfInterfaceSettingsConfiguration
+		interfaceSettings = append(interfaceSettings, map[string]interface{}{
+			"id":                current.ID,
+			"interface":         flattenReference(&current.DeviceInterface),
+			"cost":              config.Cost,
+			"priority":          config.Priority,
+			"hellointerval":     config.HelloInterval,
+			"deadinterval":      config.DeadInterval,
+			"authentication":    config.OspfAuthentication.Authentication,
+			"authenticationkey": s["authenticationkey"],
+			"md5keyid":          config.OspfAuthentication.MD5KeyId,
+		})
+	}
+	if err := d.Set("interfacesettings", interfaceSettings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", o.Type)
+
+	return diags
+}
+
+func resourceOSPFCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	vrId, err := virtualRouterID(c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("virtualrouterid", vrId)
+
+	o, err := createOSPF(c, vrId, createOSPFModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(o.ID)
+
+	err = updateOSPFInterfaces(c, vrId, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceOSPFRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceOSPFUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	vrId := d.Get("virtualrouterid").(string)
+
+	_, err := updateOSPF(c, vrId, createOSPFModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateOSPFInterfaces(c, vrId, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceOSPFRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceOSPFDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	vrId := d.Get("virtualrouterid").(string)
+
+	for _, setting := range d.Get("interfacesettings").([]interface{}) {
+		s := setting.(map[string]interface{})
+		err := deleteOSPFInterfaceSettings(c, vrId, ospfInterfaceSettings{ID: s["id"].(string)})
+		if err != nil && !isNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	var o ospf
+	o.ID = d.Get("id").(string)
+
+	err := deleteOSPF(c, vrId, o)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createOSPFModel(d *schema.ResourceData) ospf {
+	var o ospf
+
+	o.ID = d.Get("id").(string)
+	o.Version = d.Get("version").(string)
+	o.Name = d.Get("name").(string)
+	o.ProcessId = strconv.Itoa(d.Get("processid").(int))
+	o.Type = d.Get("type").(string)
+
+	o.Areas = []ospfArea{}
+	for _, area := range d.Get("areas").([]interface{}) {
+		a := area.(map[string]interface{})
+
+		var networks []ospfAreaNetwork
+		for _, network := range restoreReferenceObjectSet(a["networks"]) {
+			networks = append(networks, ospfAreaNetwork{
+				Ipv4Network: network,
+				Type:        "areanetwork",
+			})
+		}
+
+		o.Areas = append(o.Areas, ospfArea{
+			AreaId:       a["areaid"].(string),
+			AreaType:     ospfAreaType{Type: a["areatype"].(string)},
+			AreaNetworks: networks,
+			Type:         a["type"].(string),
+		})
+	}
+
+	o.RedistributeProtocols = []ospfRedistributeProtocol{}
+	for _, redistribute := range d.Get("redistribute").([]interface{}) {
+		r := redistribute.(map[string]interface{})
+		o.RedistributeProtocols = append(o.RedistributeProtocols, ospfRedistributeProtocol{
+			MetricValue:     r["metric"].(int),
+			MetricTypeValue: r["metrictype"].(string),
+			Subnets:         r["subnets"].(bool),
+			AsNumber:        r["asnumber"].(string),
+			ProcessId:       r["processid"].(string),
+			RouteMap:        restoreReference(r["routemap"]),
+			Type:            ospfRedistributeTypes[r["protocol"].(string)],
+		})
+	}
+
+	o.ProcessConfiguration = ospfProcessConfiguration{
+		RouterId: d.Get("routerid").(string),
+		Type:     "processconfiguration",
+	}
+	for _, originate := range d.Get("defaultinformationoriginate").([]interface{}) {
+		dio := originate.(map[string]interface{})
+		o.ProcessConfiguration.DefaultInformationOriginate = &ospfDefaultInformationOriginate{
+			AlwaysAdvertise: dio["alwaysadvertise"].(bool),
+			Metric:          dio["metric"].(int),
+			MetricType:      dio["metrictype"].(string),
+			RouteMap:        restoreReference(dio["routemap"]),
+			Type:            "defaultinformationoriginate",
+		}
+	}
+
+	return o
+}
+
+// updateOSPFInterfaces creates, updates and deletes interface settings matched by interface
+func updateOSPFInterfaces(c *ftdc.Client, vrId string, d *schema.ResourceData) error {
+	return syncItems(d, "interfacesettings",
+		func(s map[string]interface{}) string {
+			return returnFirstIfExists(restoreReferenceObject(s["interface"])).ID
+		},
+		func(s map[string]interface{}) (string, error) {
+			created, err := createOSPFInterfaceSettings(c, vrId, createOSPFInterfaceSettingsModel(s))
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		},
+		func(ID string, s map[string]interface{}) error {
+			current, err := getOSPFInterfaceSettings(c, vrId, ID)
+			if err != nil {
+				return err
+			}
+			settings := createOSPFInterfaceSettingsModel(s)
+			settings.ID = current.ID
+			settings.Version = current.Version
+
+			_, err = updateOSPFInterfaceSettings(c, vrId, settings)
+			return err
+		},
+		func(ID string) error {
+			return deleteOSPFInterfaceSettings(c, vrId, ospfInterfaceSettings{ID: ID})
+		},
+	)
+}
+
+func createOSPFInterfaceSettingsModel(s map[string]interface{}) ospfInterfaceSettings {
+	return ospfInterfaceSettings{
+		DeviceInterface: returnFirstIfExists(restoreReferenceObject(s["interface"])),
+		OspfInterfaceSettingsConfiguration: ospfInterfaceSettingsConfiguration{
+			Cost:          s["cost"].(int),
+			Priority:      s["priority"].(int),
+			HelloInterval: s["hellointerval"].(int),
+			DeadInterval:  s["deadinterval"].(int),
+			OspfAuthentication: ospfAuthentication{
+				Authentication: s["authentication"].(string),
+				AuthKey:        s["authenticationkey"].(string),
+				MD5KeyId:       s["md5keyid"].(int),
+				Type:           "ospfauthentication",
+			},
+			Type: "ospfinterfacesettingsconfiguration",
+		},
+		Type: "ospfinterfacesettings",
+	}
+}
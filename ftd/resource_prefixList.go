@@ -0,0 +1,208 @@
+package ftd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePrefixList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePrefixListRead,
+		CreateContext: resourcePrefixListCreate,
+		UpdateContext: resourcePrefixListUpdate,
+		DeleteContext: resourcePrefixListDelete,
+		Description:   "IPv4 or IPv6 prefix list. Used by route maps and BGP neighbors",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Entries evaluated by sequencenumber",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sequencenumber": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "[PERMIT, DENY]",
+							ValidateFunc: validation.StringInSlice([]string{"PERMIT", "DENY"}, false),
+						},
+						"ipaddress": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Prefix in CIDR notation. 10.0.0.0/8 or 2001:db8::/32",
+						},
+						"minprefixlength": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Match prefixes at least this long. Must be greater than the ipaddress prefix length",
+						},
+						"maxprefixlength": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Match prefixes at most this long",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "ipv4prefixlistentry or ipv6prefixlistentry. Derived from the list type if empty",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ipv4prefixlist or ipv6prefixlist",
+				ValidateFunc: validation.StringInSlice([]string{"ipv4prefixlist", "ipv6prefixlist"}, false),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePrefixListImport,
+		},
+	}
+}
+
+func resourcePrefixListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	list, err := getPrefixList(c, d.Get("id").(string), d.Get("type").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", list.ID)
+	d.Set("version", list.Version)
+	d.Set("name", list.Name)
+
+	entries := make([]interface{}, len(list.Entries))
+	for i, entry := range list.Entries {
+		entries[i] = map[string]interface{}{
+			"sequencenumber":  entry.SequenceNumber,
+			"action":          entry.Action,
+			"ipaddress":       entry.IpAddress,
+			"minprefixlength": entry.MinPrefixLength,
+			"maxprefixlength": entry.MaxPrefixLength,
+			"type":            entry.Type,
+		}
+	}
+	if err := d.Set("entries", entries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", list.Type)
+
+	return diags
+}
+
+func resourcePrefixListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	list, err := createPrefixList(c, createPrefixListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(list.ID)
+	resourcePrefixListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourcePrefixListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updatePrefixList(c, createPrefixListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourcePrefixListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourcePrefixListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var list prefixList
+	list.ID = d.Get("id").(string)
+	list.Type = d.Get("type").(string)
+
+	err := deletePrefixList(c, list)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+// resourcePrefixListImport accepts <type>/<id> since the endpoint depends on the list type
+func resourcePrefixListImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("expect import ID in <type>/<id> format, got: %s", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("type", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func createPrefixListModel(d *schema.ResourceData) prefixList {
+	var list prefixList
+
+	list.ID = d.Get("id").(string)
+	list.Version = d.Get("version").(string)
+	list.Name = d.Get("name").(string)
+	list.Type = d.Get("type").(string)
+
+	list.Entries = []prefixListEntry{}
+	for _, entry := range d.Get("entries").([]interface{}) {
+		e := entry.(map[string]interface{})
+
+		entryType := e["type"].(string)
+		if entryType == "" {
+			entryType = list.Type + "entry"
+		}
+
+		list.Entries = append(list.Entries, prefixListEntry{
+			SequenceNumber:  e["sequencenumber"].(int),
+			Action:          e["action"].(string),
+			IpAddress:       e["ipaddress"].(string),
+			MinPrefixLength: e["minprefixlength"].(int),
+			MaxPrefixLength: e["maxprefixlength"].(int),
+			Type:            entryType,
+		})
+	}
+
+	return list
+}
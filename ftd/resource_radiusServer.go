@@ -0,0 +1,182 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRadiusServer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceRadiusServerRead,
+		CreateContext: resourceRadiusServerCreate,
+		UpdateContext: resourceRadiusServerUpdate,
+		DeleteContext: resourceRadiusServerDelete,
+		Description:   "RADIUS server. Add it to ftd_radius_server_group to use it",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name or IP address",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Secret shared with the RADIUS server. Read responses omit it, the value in state is what was last sent",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "Seconds, from 1 to 300, to wait for a response",
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"authenticationport": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1812,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"accountingport": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1813,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"interface": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Interface the server is reached through. Routing table lookup if empty",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "physicalinterface",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "radiusidentitysource",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceRadiusServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	server, err := getRadiusServer(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", server.ID)
+	d.Set("version", server.Version)
+	d.Set("name", server.Name)
+	d.Set("host", server.Host)
+	d.Set("timeout", server.Timeout)
+	d.Set("authenticationport", server.ServerAuthenticationPort)
+	d.Set("accountingport", server.ServerAccountingPort)
+
+	if err := d.Set("interface", flattenReference(server.InterfaceForRadius)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", server.Type)
+
+	return diags
+}
+
+func resourceRadiusServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	server, err := createRadiusServer(c, createRadiusServerModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(server.ID)
+	resourceRadiusServerRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceRadiusServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateRadiusServer(c, createRadiusServerModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceRadiusServerRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceRadiusServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var server radiusServer
+	server.ID = d.Get("id").(string)
+
+	err := deleteRadiusServer(c, server)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createRadiusServerModel(d *schema.ResourceData) radiusServer {
+	var server radiusServer
+
+	server.ID = d.Get("id").(string)
+	server.Version = d.Get("version").(string)
+	server.Name = d.Get("name").(string)
+	server.Host = d.Get("host").(string)
+	server.ServerSecretKey = d.Get("secret").(string)
+	server.Timeout = d.Get("timeout").(int)
+	server.ServerAuthenticationPort = d.Get("authenticationport").(int)
+	server.ServerAccountingPort = d.Get("accountingport").(int)
+	server.InterfaceForRadius = restoreReference(d.Get("interface"))
+	server.Type = d.Get("type").(string)
+
+	return server
+}
@@ -0,0 +1,186 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRadiusServerGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceRadiusServerGroupRead,
+		CreateContext: resourceRadiusServerGroupCreate,
+		UpdateContext: resourceRadiusServerGroupUpdate,
+		DeleteContext: resourceRadiusServerGroupDelete,
+		Description:   "Group of RADIUS servers used by RA VPN and ftd_external_auth",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Servers tried in the given order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "radiusidentitysource",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"deadtime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "Minutes, from 0 to 1440, a failed server is skipped",
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"maxfailedattempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				Description:  "Failed requests, from 1 to 5, before a server is marked failed",
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"dynamicauthorization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Accept RADIUS change of authorization (CoA) requests",
+			},
+			"dynamicauthorizationport": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1700,
+				ValidateFunc: validation.IntBetween(1024, 65535),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "radiusidentitysourcegroup",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceRadiusServerGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	group, err := getRadiusServerGroup(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", group.ID)
+	d.Set("version", group.Version)
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+
+	if err := d.Set("servers", flattenReferenceModel(&group.RadiusIdentitySources)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("deadtime", group.DeadTime)
+	d.Set("maxfailedattempts", group.MaxFailedAttempts)
+	d.Set("dynamicauthorization", group.EnableDynamicAuthorization)
+	if group.DynamicAuthorizationPort != 0 {
+		d.Set("dynamicauthorizationport", group.DynamicAuthorizationPort)
+	}
+	d.Set("type", group.Type)
+
+	return diags
+}
+
+func resourceRadiusServerGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	group, err := createRadiusServerGroup(c, createRadiusServerGroupModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group.ID)
+	resourceRadiusServerGroupRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceRadiusServerGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateRadiusServerGroup(c, createRadiusServerGroupModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceRadiusServerGroupRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceRadiusServerGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var group radiusServerGroup
+	group.ID = d.Get("id").(string)
+
+	err := deleteRadiusServerGroup(c, group)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createRadiusServerGroupModel(d *schema.ResourceData) radiusServerGroup {
+	var group radiusServerGroup
+
+	group.ID = d.Get("id").(string)
+	group.Version = d.Get("version").(string)
+	group.Name = d.Get("name").(string)
+	group.Description = d.Get("description").(string)
+	group.RadiusIdentitySources = restoreReferenceObject(d.Get("servers"))
+	group.DeadTime = d.Get("deadtime").(int)
+	group.MaxFailedAttempts = d.Get("maxfailedattempts").(int)
+	group.EnableDynamicAuthorization = d.Get("dynamicauthorization").(bool)
+	if group.EnableDynamicAuthorization {
+		group.DynamicAuthorizationPort = d.Get("dynamicauthorizationport").(int)
+	}
+	group.Type = d.Get("type").(string)
+
+	return group
+}
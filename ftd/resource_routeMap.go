@@ -0,0 +1,298 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRouteMap() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceRouteMapRead,
+		CreateContext: resourceRouteMapCreate,
+		UpdateContext: resourceRouteMapUpdate,
+		DeleteContext: resourceRouteMapDelete,
+		Description:   "Route map used for OSPF redistribution and BGP network and neighbor policies",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Entries evaluated by sequencenumber. All match criteria of an entry must match",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sequencenumber": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "[PERMIT, DENY]",
+							ValidateFunc: validation.StringInSlice([]string{"PERMIT", "DENY"}, false),
+						},
+						"matchaccesslists": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Standard or extended access lists matching the route address",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "standardaccesslist",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"matchprefixlists": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Prefix lists matching the route address",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "ipv4prefixlist",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"matchaspathlists": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "AS path lists matching BGP routes",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "aspathlist",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"matchinterfaces": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Interfaces matching the route next hop",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "physicalinterface",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"setmetric": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Metric set on matched routes",
+						},
+						"setlocalpreference": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "BGP local preference set on matched routes",
+						},
+						"setweight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "BGP weight set on matched routes",
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"setaspathprepend": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "AS numbers prepended to the AS path of matched routes",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "routemapentry",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "routemap",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceRouteMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	r, err := getRouteMap(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", r.ID)
+	d.Set("version", r.Version)
+	d.Set("name", r.Name)
+
+	entries := make([]interface{}, len(r.Entries))
+	for i, entry := range r.Entries {
+		entries[i] = map[string]interface{}{
+			"sequencenumber":     entry.SequenceNumber,
+			"action":             entry.Action,
+			"matchaccesslists":   flattenReferenceModel(&entry.IPv4AccessListAddresses),
+			"matchprefixlists":   flattenReferenceModel(&entry.IPv4PrefixListAddresses),
+			"matchaspathlists":   flattenReferenceModel(&entry.AsPathAccessLists),
+			"matchinterfaces":    flattenReferenceModel(&entry.Interfaces),
+			"setmetric":          entry.MetricValue,
+			"setlocalpreference": entry.LocalPreference,
+			"setweight":          entry.Weight,
+			"setaspathprepend":   entry.PrependASPath,
+			"type":               entry.Type,
+		}
+	}
+	if err := d.Set("entries", entries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", r.Type)
+
+	return diags
+}
+
+func resourceRouteMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	r, err := createRouteMap(c, createRouteMapModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.ID)
+	resourceRouteMapRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceRouteMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateRouteMap(c, createRouteMapModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceRouteMapRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceRouteMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var r routeMap
+	r.ID = d.Get("id").(string)
+
+	err := deleteRouteMap(c, r)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createRouteMapModel(d *schema.ResourceData) routeMap {
+	var r routeMap
+
+	r.ID = d.Get("id").(string)
+	r.Version = d.Get("version").(string)
+	r.Name = d.Get("name").(string)
+
+	r.Entries = []routeMapEntry{}
+	for _, entry := range d.Get("entries").([]interface{}) {
+		e := entry.(map[string]interface{})
+
+		var prepend []string
+		for _, as := range e["setaspathprepend"].([]interface{}) {
+			prepend = append(prepend, as.(string))
+		}
+
+		r.Entries = append(r.Entries, routeMapEntry{
+			SequenceNumber:          e["sequencenumber"].(int),
+			Action:                  e["action"].(string),
+			IPv4AccessListAddresses: restoreReferenceObjectSet(e["matchaccesslists"]),
+			IPv4PrefixListAddresses: restoreReferenceObjectSet(e["matchprefixlists"]),
+			AsPathAccessLists:       restoreReferenceObjectSet(e["matchaspathlists"]),
+			Interfaces:              restoreReferenceObjectSet(e["matchinterfaces"]),
+			MetricValue:             e["setmetric"].(int),
+			LocalPreference:         e["setlocalpreference"].(int),
+			Weight:                  e["setweight"].(int),
+			PrependASPath:           prepend,
+			Type:                    e["type"].(string),
+		})
+	}
+
+	r.Type = d.Get("type").(string)
+
+	return r
+}
@@ -14,6 +14,7 @@ func resourceSecurityZone() *schema.Resource {
 		CreateContext: resourceSecurityZoneCreate,
 		UpdateContext: resourceSecurityZoneUpdate,
 		DeleteContext: resourceSecurityZoneDelete,
+		Timeouts:      defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -74,7 +75,7 @@ func resourceSecurityZone() *schema.Resource {
 }
 
 func resourceSecurityZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -83,7 +84,7 @@ func resourceSecurityZoneRead(ctx context.Context, d *schema.ResourceData, m int
 
 	securityZone, err := c.GetSecurityZone(securityZoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	d.Set("id", securityZone.ID)
@@ -102,7 +103,7 @@ func resourceSecurityZoneRead(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceSecurityZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -140,7 +141,7 @@ func resourceSecurityZoneCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSecurityZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -150,14 +151,14 @@ func resourceSecurityZoneDelete(ctx context.Context, d *schema.ResourceData, m i
 
 	err := c.DeleteSecurityZone(securityZone)
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(err)
 	}
 
 	return diags
 }
 
 func resourceSecurityZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -0,0 +1,178 @@
+package ftd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSmartLicense() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSmartLicenseRead,
+		CreateContext: resourceSmartLicenseCreate,
+		UpdateContext: resourceSmartLicenseUpdate,
+		DeleteContext: resourceSmartLicenseDelete,
+		Description:   "Smart licensing registration of the device. Destroy unregisters the device",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connectiontype": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "REGISTER with registrationtoken or start the 90 day EVALUATION period. [REGISTER, EVALUATION]",
+				ValidateFunc: validation.StringInSlice([]string{"REGISTER", "EVALUATION"}, false),
+			},
+			"registrationtoken": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Smart account token, required for REGISTER. It is only used for registration and can not be read back",
+			},
+			"registrationstatus": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorizationstatus": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluationdaysremaining": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "smartagentconnection",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceSmartLicenseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	connection, err := getSmartAgentConnection(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// device was unregistered outside of terraform
+	if connection == nil {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("id", connection.ID)
+	d.Set("version", connection.Version)
+	d.Set("connectiontype", connection.ConnectionType)
+	d.Set("type", connection.Type)
+
+	status, err := getSmartAgentStatus(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("registrationstatus", status.RegistrationStatus)
+	d.Set("authorizationstatus", status.AuthorizationStatus)
+	d.Set("evaluationdaysremaining", status.EvaluationPeriodRemaining)
+
+	return diags
+}
+
+func resourceSmartLicenseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	connection, err := createSmartLicenseModel(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing, err := getSmartAgentConnection(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only one connection can exist, evaluation is converted to registration in place
+	if existing != nil {
+		connection.ID = existing.ID
+		connection.Version = existing.Version
+		created, err := updateSmartAgentConnection(c, connection)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(created.ID)
+	} else {
+		created, err := createSmartAgentConnection(c, connection)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(created.ID)
+	}
+
+	return resourceSmartLicenseRead(ctx, d, m)
+}
+
+func resourceSmartLicenseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	connection, err := createSmartLicenseModel(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = updateSmartAgentConnection(c, connection)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceSmartLicenseRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceSmartLicenseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var connection smartAgentConnection
+	connection.ID = d.Get("id").(string)
+
+	err := deleteSmartAgentConnection(c, connection)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createSmartLicenseModel(d *schema.ResourceData) (smartAgentConnection, error) {
+	var connection smartAgentConnection
+
+	connection.ID = d.Get("id").(string)
+	connection.Version = d.Get("version").(string)
+	connection.ConnectionType = d.Get("connectiontype").(string)
+	connection.Token = d.Get("registrationtoken").(string)
+	connection.Type = d.Get("type").(string)
+
+	if connection.ConnectionType == "REGISTER" && connection.Token == "" {
+		return connection, fmt.Errorf("registrationtoken is required for REGISTER")
+	}
+
+	return connection, nil
+}
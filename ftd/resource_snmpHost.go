@@ -0,0 +1,243 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSNMPHost() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSNMPHostRead,
+		CreateContext: resourceSNMPHostCreate,
+		UpdateContext: resourceSNMPHostUpdate,
+		DeleteContext: resourceSNMPHostDelete,
+		Description:   "SNMP manager allowed to poll the device and receive traps. Uses SNMPv2c with community or SNMPv3 with snmpuser",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"manageraddress": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Host network object of the SNMP manager",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "networkobject",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"interface": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Interface the SNMP manager is reachable through",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "physicalinterface",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"udpport": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      162,
+				Description:  "UDP port traps are sent to",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"pollenabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"trapenabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"community": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "SNMPv2c community string. Masked in FDM responses, a community edited on the device keeps its old value in state",
+				ExactlyOneOf: []string{"community", "snmpuser"},
+			},
+			"snmpuser": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "SNMPv3 user from ftd_snmp_server users",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "snmpuser",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"community", "snmpuser"},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "snmphost",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceSNMPHostRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	host, err := getSNMPHost(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", host.ID)
+	d.Set("version", host.Version)
+	d.Set("name", host.Name)
+
+	if err := d.Set("manageraddress", flattenReference(&host.ManagerAddress)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("interface", flattenReference(&host.Interface)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("udpport", host.UdpPort)
+	d.Set("pollenabled", host.PollEnabled)
+	d.Set("trapenabled", host.TrapEnabled)
+
+	if err := d.Set("snmpuser", flattenReference(host.SecurityConfiguration.Authentication)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", host.Type)
+
+	return diags
+}
+
+func resourceSNMPHostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	host, err := createSNMPHost(c, createSNMPHostModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(host.ID)
+	resourceSNMPHostRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceSNMPHostUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateSNMPHost(c, createSNMPHostModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceSNMPHostRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceSNMPHostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var host snmpHost
+	host.ID = d.Get("id").(string)
+
+	err := deleteSNMPHost(c, host)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createSNMPHostModel(d *schema.ResourceData) snmpHost {
+	var host snmpHost
+
+	host.ID = d.Get("id").(string)
+	host.Version = d.Get("version").(string)
+	host.Name = d.Get("name").(string)
+	host.ManagerAddress = returnFirstIfExists(restoreReferenceObject(d.Get("manageraddress")))
+	host.Interface = returnFirstIfExists(restoreReferenceObject(d.Get("interface")))
+	host.UdpPort = d.Get("udpport").(int)
+	host.PollEnabled = d.Get("pollenabled").(bool)
+	host.TrapEnabled = d.Get("trapenabled").(bool)
+
+	if user := restoreReference(d.Get("snmpuser")); user != nil {
+		host.SecurityConfiguration = snmpSecurityConfiguration{
+			Authentication: user,
+			Type:           "snmpv3securityconfiguration",
+		}
+	} else {
+		host.SecurityConfiguration = snmpSecurityConfiguration{
+			Community: d.Get("community").(string),
+			Type:      "snmpv2csecurityconfiguration",
+		}
+	}
+
+	host.Type = d.Get("type").(string)
+
+	return host
+}
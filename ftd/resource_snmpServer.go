@@ -0,0 +1,286 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+func resourceSNMPServer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSNMPServerRead,
+		CreateContext: resourceSNMPServerCreate,
+		UpdateContext: resourceSNMPServerUpdate,
+		DeleteContext: resourceSNMPServerDelete,
+		Description:   "Device SNMP agent settings and SNMPv3 users. Create will import device SNMP settings, destroy clears them and removes the users",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Device location reported as sysLocation",
+			},
+			"contact": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Device contact reported as sysContact",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      161,
+				Description:  "UDP port the SNMP agent listens on",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "SNMPv3 users. Reference them from ftd_snmp_host snmpuser",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"securitylevel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "[AUTH, NOAUTH, PRIV]",
+							ValidateFunc: validation.StringInSlice([]string{"AUTH", "NOAUTH", "PRIV"}, false),
+						},
+						"authenticationalgorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Required for AUTH and PRIV. [SHA, SHA256]",
+							ValidateFunc: validation.StringInSlice([]string{"SHA", "SHA256"}, false),
+						},
+						"authenticationpassword": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Required for AUTH and PRIV. Sent with every update of the users, FDM responses leave it out so a password changed on the device is not detected",
+						},
+						"encryptionalgorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Required for PRIV. [AES128, AES192, AES256, 3DES]",
+							ValidateFunc: validation.StringInSlice([]string{"AES128", "AES192", "AES256", "3DES"}, false),
+						},
+						"encryptionpassword": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Required for PRIV. Like authenticationpassword it is only compared with the value in state",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "snmpuser",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "snmpserver",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceSNMPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	server, err := getSNMPServer(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("id", server.ID)
+	d.Set("version", server.Version)
+	d.Set("location", server.Location)
+	d.Set("contact", server.Contact)
+	d.Set("port", server.Port)
+	d.Set("type", server.Type)
+
+	current, err := getSNMPUsers(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// users are matched by name, users in state keep their order and their passwords are taken over
+	// from it. Users added on the device follow, users deleted there are planned for creation again.
+	byName := make(map[string]snmpUser)
+	for _, user := range current {
+		byName[user.Name] = user
+	}
+
+	var users []interface{}
+	for _, user := range d.Get("users").([]interface{}) {
+		u := user.(map[string]interface{})
+		if found, ok := byName[u["name"].(string)]; ok {
+			users = append(users, flattenSNMPUser(found, u))
+			delete(byName, found.Name)
+		}
+	}
+	for _, user := range current {
+		if _, ok := byName[user.Name]; ok {
+			users = append(users, flattenSNMPUser(user, nil))
+		}
+	}
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSNMPServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+
+	server, err := getSNMPServer(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(server.ID)
+	d.Set("version", server.Version)
+
+	return resourceSNMPServerUpdate(ctx, d, m)
+}
+
+func resourceSNMPServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var server snmpServer
+
+	server.ID = d.Get("id").(string)
+	server.Version = d.Get("version").(string)
+	server.Location = d.Get("location").(string)
+	server.Contact = d.Get("contact").(string)
+	server.Port = d.Get("port").(int)
+	server.Type = d.Get("type").(string)
+
+	_, err := updateSNMPServer(c, server)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateSNMPUsers(c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceSNMPServerRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceSNMPServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	for _, user := range d.Get("users").([]interface{}) {
+		u := user.(map[string]interface{})
+		err := deleteSNMPUser(c, snmpUser{ID: u["id"].(string)})
+		if err != nil && !isNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	server, err := getSNMPServer(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	server.Location = ""
+	server.Contact = ""
+
+	_, err = updateSNMPServer(c, *server)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// updateSNMPUsers creates, updates and deletes users matched by name
+func updateSNMPUsers(c *ftdc.Client, d *schema.ResourceData) error {
+	return syncItems(d, "users",
+		func(u map[string]interface{}) string {
+			return u["name"].(string)
+		},
+		func(u map[string]interface{}) (string, error) {
+			created, err := createSNMPUser(c, createSNMPUserModel(u))
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		},
+		func(ID string, u map[string]interface{}) error {
+			current, err := getSNMPUser(c, ID)
+			if err != nil {
+				return err
+			}
+			su := createSNMPUserModel(u)
+			su.ID = current.ID
+			su.Version = current.Version
+
+			_, err = updateSNMPUser(c, su)
+			return err
+		},
+		func(ID string) error {
+			return deleteSNMPUser(c, snmpUser{ID: ID})
+		},
+	)
+}
+
+// flattenSNMPUser returns user with the passwords of configured, which FDM does not return
+func flattenSNMPUser(user snmpUser, configured map[string]interface{}) map[string]interface{} {
+	u := map[string]interface{}{
+		"id":                      user.ID,
+		"name":                    user.Name,
+		"securitylevel":           user.SecurityLevel,
+		"authenticationalgorithm": user.AuthenticationAlgorithm,
+		"encryptionalgorithm":     user.EncryptionAlgorithm,
+		"type":                    user.Type,
+	}
+	if configured != nil {
+		u["authenticationpassword"] = configured["authenticationpassword"]
+		u["encryptionpassword"] = configured["encryptionpassword"]
+	}
+	return u
+}
+
+func createSNMPUserModel(u map[string]interface{}) snmpUser {
+	return snmpUser{
+		Name:                    u["name"].(string),
+		SecurityLevel:           u["securitylevel"].(string),
+		AuthenticationAlgorithm: u["authenticationalgorithm"].(string),
+		AuthenticationPassword:  u["authenticationpassword"].(string),
+		EncryptionAlgorithm:     u["encryptionalgorithm"].(string),
+		EncryptionPassword:      u["encryptionpassword"].(string),
+		Type:                    u["type"].(string),
+	}
+}
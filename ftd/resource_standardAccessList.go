@@ -0,0 +1,181 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceStandardAccessList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceStandardAccessListRead,
+		CreateContext: resourceStandardAccessListCreate,
+		UpdateContext: resourceStandardAccessListUpdate,
+		DeleteContext: resourceStandardAccessListDelete,
+		Description:   "Standard access list matching destination networks. Used by route maps",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Entries evaluated in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "[PERMIT, DENY]",
+							ValidateFunc: validation.StringInSlice([]string{"PERMIT", "DENY"}, false),
+						},
+						"networks": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Networks matched by the entry. Empty matches any",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "networkobject",
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "standardaccesslistentry",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "standardaccesslist",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStandardAccessListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	accessList, err := getStandardAccessList(c, d.Get("id").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", accessList.ID)
+	d.Set("version", accessList.Version)
+	d.Set("name", accessList.Name)
+
+	entries := make([]interface{}, len(accessList.Entries))
+	for i, entry := range accessList.Entries {
+		entries[i] = map[string]interface{}{
+			"action":   entry.Action,
+			"networks": flattenReferenceModel(&entry.Networks),
+			"type":     entry.Type,
+		}
+	}
+	if err := d.Set("entries", entries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("type", accessList.Type)
+
+	return diags
+}
+
+func resourceStandardAccessListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	accessList, err := createStandardAccessList(c, createStandardAccessListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(accessList.ID)
+	resourceStandardAccessListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceStandardAccessListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateStandardAccessList(c, createStandardAccessListModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceStandardAccessListRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceStandardAccessListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var accessList standardAccessList
+	accessList.ID = d.Get("id").(string)
+
+	err := deleteStandardAccessList(c, accessList)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createStandardAccessListModel(d *schema.ResourceData) standardAccessList {
+	var accessList standardAccessList
+
+	accessList.ID = d.Get("id").(string)
+	accessList.Version = d.Get("version").(string)
+	accessList.Name = d.Get("name").(string)
+
+	accessList.Entries = []standardAccessListEntry{}
+	for _, entry := range d.Get("entries").([]interface{}) {
+		e := entry.(map[string]interface{})
+		accessList.Entries = append(accessList.Entries, standardAccessListEntry{
+			Action:   e["action"].(string),
+			Networks: restoreReferenceObjectSet(e["networks"]),
+			Type:     e["type"].(string),
+		})
+	}
+
+	accessList.Type = d.Get("type").(string)
+
+	return accessList
+}
@@ -14,6 +14,7 @@ func resourceTcpUdpPort() *schema.Resource {
 		CreateContext: resourceTcpUdpPortCreate,
 		UpdateContext: resourceTcpUdpPortUpdate,
 		DeleteContext: resourceTcpUdpPortDelete,
+		Timeouts:      defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeString,
@@ -53,11 +54,11 @@ func resourceTcpUdpPort() *schema.Resource {
 
 func resourceTcpUdpPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	tcpUpdPort, err := c.GetTcpUdpPort(d.Get("id").(string), d.Get("type").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	d.Set("id", tcpUpdPort.ID)
@@ -73,7 +74,7 @@ func resourceTcpUdpPortRead(ctx context.Context, d *schema.ResourceData, m inter
 
 func resourceTcpUdpPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 
 	var tcpUdpPort ftdc.TcpUdpPort
 
@@ -96,7 +97,7 @@ func resourceTcpUdpPortCreate(ctx context.Context, d *schema.ResourceData, m int
 
 func resourceTcpUdpPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var tcpUdpPort ftdc.TcpUdpPort
 
 	tcpUdpPort.ID = d.Get("id").(string)
@@ -117,7 +118,7 @@ func resourceTcpUdpPortUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 func resourceTcpUdpPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	c := m.(*ftdc.Client)
+	c := clientFromMeta(ctx, d, m)
 	var tcpUdpPort ftdc.TcpUdpPort
 
 	tcpUdpPort.ID = d.Get("id").(string)
@@ -128,7 +129,7 @@ func resourceTcpUdpPortDelete(ctx context.Context, d *schema.ResourceData, m int
 
 	err := c.DeleteTcpUdpPort(&tcpUdpPort)
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(err)
 	}
 
 	return diags
@@ -0,0 +1,142 @@
+package ftd
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTrustedCACertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTrustedCACertificateRead,
+		CreateContext: resourceTrustedCACertificateCreate,
+		UpdateContext: resourceTrustedCACertificateUpdate,
+		DeleteContext: resourceTrustedCACertificateDelete,
+		Description:   "Trusted CA certificate used to validate servers and clients, e.g. LDAPS, RA VPN client certificates and SSL decryption",
+		Timeouts:      defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cert": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "PEM encoded CA certificate",
+				DiffSuppressFunc: suppressPEMDiff,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validfrom": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validto": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "externalcacertificate",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTrustedCACertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	cert, err := getCertificate(c, d.Get("id").(string), d.Get("type").(string))
+	if err != nil {
+		return readError(d, err)
+	}
+
+	d.Set("id", cert.ID)
+	d.Set("version", cert.Version)
+	d.Set("name", cert.Name)
+	d.Set("cert", cert.Cert)
+	d.Set("subject", cert.SubjectCommonName)
+	d.Set("issuer", cert.IssuerCommonName)
+	d.Set("validfrom", cert.ValidityStartDate)
+	d.Set("validto", cert.ValidityEndDate)
+	d.Set("type", cert.Type)
+
+	return diags
+}
+
+func resourceTrustedCACertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	cert, err := createCertificate(c, createTrustedCACertificateModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(cert.ID)
+	resourceTrustedCACertificateRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceTrustedCACertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	_, err := updateCertificate(c, createTrustedCACertificateModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceTrustedCACertificateRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceTrustedCACertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, d, m)
+	var diags diag.Diagnostics
+
+	var cert certificate
+	cert.ID = d.Get("id").(string)
+	cert.Type = d.Get("type").(string)
+
+	err := deleteCertificate(c, cert)
+	if err != nil {
+		return deleteError(err)
+	}
+
+	return diags
+}
+
+func createTrustedCACertificateModel(d *schema.ResourceData) certificate {
+	var cert certificate
+
+	cert.ID = d.Get("id").(string)
+	cert.Version = d.Get("version").(string)
+	cert.Name = d.Get("name").(string)
+	cert.Cert = d.Get("cert").(string)
+	cert.Type = d.Get("type").(string)
+
+	return cert
+}
@@ -0,0 +1,338 @@
+package ftd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+// tokenRefreshMargin - access tokens are refreshed when they expire within this margin
+const tokenRefreshMargin = time.Minute
+
+// shutdownTimeout - time Shutdown has to revoke all tokens
+const shutdownTimeout = 1500 * time.Millisecond
+
+// sessions are revoked by Shutdown, FDM limits the number of concurrent sessions per user
+var sessions struct {
+	sync.Mutex
+	items []*session
+}
+
+// session is the transport of an authenticated client. It owns the access token: refreshes it
+// before it lapses, logs in again and retries once on 401 and sets it on every request.
+type session struct {
+	mu     sync.Mutex
+	client *ftdc.Client
+	base   http.RoundTripper
+	token  ftdc.AuthResponse
+	issued time.Time
+	// issued outside of the provider, e.g. FTD_TOKEN. It is used as is, never refreshed or revoked.
+	preIssued bool
+}
+
+// newSession logs in with the client credentials and installs the session as the client transport
+func newSession(ctx context.Context, c *ftdc.Client) (*session, error) {
+	base := c.HTTPClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	s := &session{
+		client: c,
+		base:   base,
+	}
+
+	s.mu.Lock()
+	err := s.logIn(ctx)
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	c.HTTPClient.Transport = s
+
+	sessions.Lock()
+	sessions.items = append(sessions.items, s)
+	sessions.Unlock()
+
+	return s, nil
+}
+
+// newTokenSession installs a session using a pre-issued access token as the client transport
+func newTokenSession(c *ftdc.Client, token string) *session {
+	base := c.HTTPClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	s := &session{
+		client:    c,
+		base:      base,
+		token:     ftdc.AuthResponse{AccessToken: token, TokenType: "Bearer"},
+		issued:    time.Now(),
+		preIssued: true,
+	}
+	c.AuthResponse = s.token
+	c.AuthTime = s.issued
+	c.HTTPClient.Transport = s
+
+	return s
+}
+
+// clientCopy returns a copy of the session client. The session updates the token fields of the
+// client under s.mu, so the client must not be copied or read without it while the session is in use.
+func (s *session) clientCopy() ftdc.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.client
+}
+
+// Shutdown revokes the tokens of all sessions. Called once the plugin stops serving, Terraform
+// kills the plugin about 2 seconds later so all sessions are revoked at once within shutdownTimeout.
+func Shutdown() {
+	sessions.Lock()
+	items := sessions.items
+	sessions.items = nil
+	sessions.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for _, s := range items {
+			wg.Add(1)
+			go func(s *session) {
+				defer wg.Done()
+				s.revoke(ctx)
+			}(s)
+		}
+		wg.Wait()
+		close(done)
+	}()
+
+	// a session busy logging in holds its lock, do not wait for it past the deadline
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+func (s *session) RoundTrip(req *http.Request) (*http.Response, error) {
+	// ftd-client logs in on its own when it thinks the token expired, answer with the session token instead
+	if isTokenRequest(req) {
+		return s.tokenResponse(req)
+	}
+
+	token, err := s.accessToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.base.RoundTrip(withToken(req, token))
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	// body can not be sent again
+	if req.Body != nil && req.GetBody == nil {
+		return res, nil
+	}
+	res.Body.Close()
+
+	// token was revoked or expired on the device, log in again and retry once
+	token, err = s.reauthenticate(req.Context(), token)
+	if err != nil {
+		return nil, err
+	}
+
+	retry := withToken(req, token)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+
+	return s.base.RoundTrip(retry)
+}
+
+// accessToken returns a token valid for at least tokenRefreshMargin
+func (s *session) accessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.preIssued {
+		return s.token.AccessToken, nil
+	}
+
+	now := time.Now()
+	if now.Add(tokenRefreshMargin).Before(s.expires(s.token.ExpiresIn)) {
+		return s.token.AccessToken, nil
+	}
+
+	if s.token.RefreshToken != "" && now.Add(tokenRefreshMargin).Before(s.expires(s.token.RefreshExpiresIn)) {
+		if err := s.refresh(ctx); err == nil {
+			return s.token.AccessToken, nil
+		}
+	}
+
+	if err := s.logIn(ctx); err != nil {
+		return "", err
+	}
+	return s.token.AccessToken, nil
+}
+
+// reauthenticate logs in again unless another request already replaced the rejected token
+func (s *session) reauthenticate(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken != rejected {
+		return s.token.AccessToken, nil
+	}
+
+	if s.preIssued {
+		return "", fmt.Errorf("the access token was rejected by FDM, issue a new one")
+	}
+
+	if err := s.logIn(ctx); err != nil {
+		return "", err
+	}
+	return s.token.AccessToken, nil
+}
+
+func (s *session) expires(seconds int) time.Time {
+	return s.issued.Add(time.Duration(seconds) * time.Second)
+}
+
+func (s *session) logIn(ctx context.Context) error {
+	return s.requestToken(ctx, s.client.Auth)
+}
+
+func (s *session) refresh(ctx context.Context) error {
+	return s.requestToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": s.token.RefreshToken,
+	})
+}
+
+// revoke invalidates the access token, errors are ignored as the plugin is shutting down
+func (s *session) revoke(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken == "" || s.preIssued {
+		return
+	}
+
+	res, err := s.postToken(ctx, map[string]string{
+		"grant_type":      "revoke_token",
+		"access_token":    s.token.AccessToken,
+		"token_to_revoke": s.token.AccessToken,
+	})
+	if err == nil {
+		res.Body.Close()
+	}
+	s.token = ftdc.AuthResponse{}
+}
+
+// requestToken replaces the session token, callers hold s.mu
+func (s *session) requestToken(ctx context.Context, grant interface{}) error {
+	res, err := s.postToken(ctx, grant)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	}
+
+	token := ftdc.AuthResponse{}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return err
+	}
+
+	s.token = token
+	s.issued = time.Now()
+
+	// keep ftd-client view in sync, it checks expiry before each of its own calls
+	s.client.AuthResponse = token
+	s.client.AuthTime = s.issued
+
+	return nil
+}
+
+func (s *session) postToken(ctx context.Context, grant interface{}) (*http.Response, error) {
+	b, err := json.Marshal(grant)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/fdm/v6/fdm/token", s.client.FTDURL), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	return s.base.RoundTrip(req)
+}
+
+func (s *session) tokenResponse(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		io.Copy(ioutil.Discard, req.Body)
+		req.Body.Close()
+	}
+
+	if _, err := s.accessToken(req.Context()); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	b, err := json.Marshal(s.token)
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
+
+func isTokenRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/fdm/token")
+}
+
+func withToken(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return r
+}
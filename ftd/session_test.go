@@ -0,0 +1,59 @@
+package ftd
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ftdc "github.com/mr-olenoid/ftd-client"
+)
+
+func TestShutdown(t *testing.T) {
+	var revoked int32
+	fast := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&revoked, 1)
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+	})
+	// an unreachable device answers only once the request is canceled
+	unreachable := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+
+	newTestSession := func(base http.RoundTripper) *session {
+		return &session{
+			client: &ftdc.Client{FTDURL: "https://ftd"},
+			base:   base,
+			token:  ftdc.AuthResponse{AccessToken: "token"},
+		}
+	}
+
+	// the busy session is logging in and holds its lock past the deadline
+	busy := newTestSession(fast)
+	busy.mu.Lock()
+	defer busy.mu.Unlock()
+
+	sessions.items = []*session{
+		newTestSession(unreachable),
+		newTestSession(unreachable),
+		busy,
+		newTestSession(fast),
+		newTestSession(fast),
+	}
+
+	start := time.Now()
+	Shutdown()
+
+	if elapsed := time.Since(start); elapsed > shutdownTimeout+500*time.Millisecond {
+		t.Errorf("Shutdown() took %s, want at most %s", elapsed, shutdownTimeout)
+	}
+	if n := atomic.LoadInt32(&revoked); n != 2 {
+		t.Errorf("revoked %d tokens of reachable devices, want 2", n)
+	}
+	if len(sessions.items) != 0 {
+		t.Errorf("Shutdown() kept %d sessions", len(sessions.items))
+	}
+}
@@ -0,0 +1,288 @@
+package ftd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTransport returns the transport every FDM call goes through, verified against the
+// system roots plus caCertPEM unless insecureSkipVerify is set
+func newTransport(caCertPEM string, caCertFile string, insecureSkipVerify bool, serverName string) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecureSkipVerify,
+		ServerName:         serverName,
+	}
+
+	if caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("can not read ca_cert_file: %w", err)
+		}
+		caCertPEM = string(pem)
+	}
+
+	if caCertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCertPEM)) {
+			return nil, fmt.Errorf("no PEM certificate found in CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.ResponseHeaderTimeout = requestTimeout
+
+	return transport, nil
+}
+
+// requestTimeout limits the wait for a response to a single FDM call. ftd-client applied it to the
+// whole call, which left no room for retries and cut off large downloads.
+const requestTimeout = 20 * time.Second
+
+// defaultRetryableStatusCodes are used when retryable_status_codes is not set
+var defaultRetryableStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// retryTransport retries FDM calls failing with a retryable status, e.g. 503 during a deployment,
+// with exponential backoff. A 422 version mismatch means the object was changed since it was read,
+// it is returned as is so the change is planned again instead of overwritten.
+type retryTransport struct {
+	next        http.RoundTripper
+	maxRetries  int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	statusCodes []int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.attempt(req, body)
+
+		reason := t.retryReason(req, res, err)
+		if reason == "" || attempt >= t.maxRetries {
+			return res, err
+		}
+
+		if res != nil {
+			res.Body.Close()
+		}
+
+		backoff := t.backoff(attempt)
+		tflog.SubsystemWarn(ctx, logSubsystem, "Retrying FDM request", map[string]interface{}{
+			"method":      req.Method,
+			"path":        req.URL.Path,
+			"attempt":     attempt + 1,
+			"max_retries": t.maxRetries,
+			"reason":      reason,
+			"backoff":     backoff.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// attempt sends req with a fresh copy of body
+func (t *retryTransport) attempt(req *http.Request, body []byte) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	if body != nil {
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	return t.next.RoundTrip(r)
+}
+
+// retryReason returns why the request should be sent again, empty if it should not
+func (t *retryTransport) retryReason(req *http.Request, res *http.Response, err error) string {
+	if err != nil {
+		// a POST may have reached the device, sending it again could create a duplicate
+		if req.Method == http.MethodPost || req.Context().Err() != nil {
+			return ""
+		}
+		return err.Error()
+	}
+
+	for _, code := range t.statusCodes {
+		if res.StatusCode == code {
+			return res.Status
+		}
+	}
+
+	return ""
+}
+
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	backoff := t.minBackoff
+	for i := 0; i < attempt && backoff < t.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > t.maxBackoff {
+		backoff = t.maxBackoff
+	}
+
+	// jitter keeps parallel resources from retrying in lockstep
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// notFoundTransport turns 404 responses into a notFoundError. ftd-client only returns the
+// status as text, the error of a transport reaches the caller wrapped in a *url.Error.
+type notFoundTransport struct {
+	next http.RoundTripper
+}
+
+func (t *notFoundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusNotFound {
+		return res, err
+	}
+	defer res.Body.Close()
+
+	body, _ := ioutil.ReadAll(res.Body)
+	return nil, &notFoundError{Body: string(body)}
+}
+
+// contextTransport sends every request with ctx, see clientFromMeta
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
+
+func newRetryTransport(next http.RoundTripper, settings retryTransport) *retryTransport {
+	settings.next = next
+	return &settings
+}
+
+// retrySettings reads the retry arguments of the provider, the returned transport has no next yet
+func retrySettings(d *schema.ResourceData) retryTransport {
+	t := retryTransport{
+		maxRetries:  d.Get("max_retries").(int),
+		minBackoff:  time.Duration(d.Get("retry_min_backoff").(int)) * time.Second,
+		maxBackoff:  time.Duration(d.Get("retry_max_backoff").(int)) * time.Second,
+		statusCodes: defaultRetryableStatusCodes,
+	}
+
+	if codes := d.Get("retryable_status_codes").([]interface{}); len(codes) > 0 {
+		t.statusCodes = nil
+		for _, code := range codes {
+			t.statusCodes = append(t.statusCodes, code.(int))
+		}
+	}
+
+	if t.maxBackoff < t.minBackoff {
+		t.maxBackoff = t.minBackoff
+	}
+
+	return t
+}
+
+// writeLockTransport serializes writes to the same FDM collection and caps concurrent writes.
+// Parallel writes race on object versions, e.g. every access rule change bumps its policy version.
+// Reads are not limited.
+type writeLockTransport struct {
+	next   http.RoundTripper
+	writes chan struct{}
+
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+}
+
+func newWriteLockTransport(next http.RoundTripper, maxConcurrentWrites int) *writeLockTransport {
+	return &writeLockTransport{
+		next:   next,
+		writes: make(chan struct{}, maxConcurrentWrites),
+		locks:  make(map[string]chan struct{}),
+	}
+}
+
+func (t *writeLockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+
+	// one slot channels instead of mutexes, so waiting writes give up with their ctx
+	lock := t.lock(writeLockKey(req.Method, req.URL.Path))
+	select {
+	case lock <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-lock }()
+
+	select {
+	case t.writes <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.writes }()
+
+	return t.next.RoundTrip(req)
+}
+
+func (t *writeLockTransport) lock(key string) chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lock, ok := t.locks[key]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		t.locks[key] = lock
+	}
+	return lock
+}
+
+// writeLockKey returns the collection a write goes to, e.g. object/networks. Policies share
+// one key with everything below them, e.g. policy/accesspolicies/<id> for its access rules.
+func writeLockKey(method string, path string) string {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(path, "/api/fdm/v6"), "/"), "/")
+
+	if segments[0] == "policy" && len(segments) >= 3 {
+		return strings.Join(segments[:3], "/")
+	}
+
+	// updates and deletes address an object of the collection
+	if method != http.MethodPost && len(segments) > 1 {
+		segments = segments[:len(segments)-1]
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,189 @@
+package ftd
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc - http.RoundTripper answering with a function, e.g. a list of canned statuses
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		name     string
+		statuses []int
+		want     int
+		attempts int
+	}{
+		{name: "recovers", statuses: []int{503, 503, 200}, want: 200, attempts: 3},
+		{name: "gives up", statuses: []int{503, 503, 503, 503, 503}, want: 503, attempts: 4},
+		{name: "conflict is returned", statuses: []int{422, 200}, want: 422, attempts: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var bodies []string
+			next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				b, _ := ioutil.ReadAll(req.Body)
+				bodies = append(bodies, string(b))
+				status := tc.statuses[len(bodies)-1]
+				return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+			})
+			transport := newRetryTransport(next, retryTransport{maxRetries: 3, statusCodes: defaultRetryableStatusCodes})
+
+			req, _ := http.NewRequest(http.MethodPut, "https://ftd/api/fdm/v6/object/networks/1", strings.NewReader(`{"version":"a"}`))
+			res, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res.StatusCode != tc.want || len(bodies) != tc.attempts {
+				t.Errorf("got %d after %d attempts, want %d after %d", res.StatusCode, len(bodies), tc.want, tc.attempts)
+			}
+			for _, body := range bodies {
+				if body != `{"version":"a"}` {
+					t.Errorf("sent body %s, want the original one", body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryReason(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name   string
+		method string
+		ctx    context.Context
+		status int
+		err    error
+		retry  bool
+	}{
+		{name: "service unavailable", method: http.MethodGet, status: http.StatusServiceUnavailable, retry: true},
+		{name: "bad gateway on update", method: http.MethodPut, status: http.StatusBadGateway, retry: true},
+		{name: "gateway timeout on create", method: http.MethodPost, status: http.StatusGatewayTimeout, retry: true},
+		{name: "version mismatch", method: http.MethodPut, status: http.StatusUnprocessableEntity},
+		{name: "bad request", method: http.MethodPut, status: http.StatusBadRequest},
+		{name: "not found", method: http.MethodGet, status: http.StatusNotFound},
+		{name: "ok", method: http.MethodGet, status: http.StatusOK},
+		{name: "network error on read", method: http.MethodGet, err: errors.New("connection reset"), retry: true},
+		{name: "network error on delete", method: http.MethodDelete, err: errors.New("connection reset"), retry: true},
+		{name: "network error on create", method: http.MethodPost, err: errors.New("connection reset")},
+		{name: "canceled", method: http.MethodGet, ctx: canceled, err: context.Canceled},
+	}
+
+	transport := &retryTransport{statusCodes: defaultRetryableStatusCodes}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			req, err := http.NewRequestWithContext(ctx, tc.method, "https://ftd/api/fdm/v6/object/networks", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var res *http.Response
+			if tc.err == nil {
+				res = &http.Response{StatusCode: tc.status, Status: http.StatusText(tc.status)}
+			}
+
+			reason := transport.retryReason(req, res, tc.err)
+			if (reason != "") != tc.retry {
+				t.Errorf("retryReason() = %q, want retry %v", reason, tc.retry)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		name     string
+		min, max time.Duration
+		attempt  int
+		from, to time.Duration
+	}{
+		{name: "first", min: time.Second, max: 30 * time.Second, attempt: 0, from: 500 * time.Millisecond, to: time.Second},
+		{name: "doubled", min: time.Second, max: 30 * time.Second, attempt: 2, from: 2 * time.Second, to: 4 * time.Second},
+		{name: "capped", min: time.Second, max: 30 * time.Second, attempt: 10, from: 15 * time.Second, to: 30 * time.Second},
+		{name: "no backoff", min: 0, max: 0, attempt: 3, from: 0, to: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &retryTransport{minBackoff: tc.min, maxBackoff: tc.max}
+			for i := 0; i < 20; i++ {
+				if backoff := transport.backoff(tc.attempt); backoff < tc.from || backoff > tc.to {
+					t.Fatalf("backoff(%d) = %s, want between %s and %s", tc.attempt, backoff, tc.from, tc.to)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteLockKey(t *testing.T) {
+	cases := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{http.MethodPost, "/api/fdm/v6/object/networks", "object/networks"},
+		{http.MethodPut, "/api/fdm/v6/object/networks/1234", "object/networks"},
+		{http.MethodDelete, "/api/fdm/v6/object/networks/1234", "object/networks"},
+		{http.MethodPost, "/api/fdm/v6/policy/accesspolicies/abc/accessrules", "policy/accesspolicies/abc"},
+		{http.MethodPut, "/api/fdm/v6/policy/accesspolicies/abc/accessrules/1", "policy/accesspolicies/abc"},
+		{http.MethodPut, "/api/fdm/v6/policy/accesspolicies/abc", "policy/accesspolicies/abc"},
+		{http.MethodPut, "/api/fdm/v6/devices/default/routing/virtualrouters/vr/ospf/1", "devices/default/routing/virtualrouters/vr/ospf"},
+		{http.MethodPost, "/api/fdm/v6/action/backup", "action/backup"},
+		{http.MethodPut, "/api/fdm/v6/devicesettings", "devicesettings"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			if got := writeLockKey(tc.method, tc.path); got != tc.want {
+				t.Errorf("writeLockKey() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWriteLockTransportCancel(t *testing.T) {
+	release := make(chan struct{})
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		<-release
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+	})
+	transport := newWriteLockTransport(next, 4)
+
+	// the first write holds the lock of object/networks until released
+	go func() {
+		req, _ := http.NewRequest(http.MethodPost, "https://ftd/api/fdm/v6/object/networks", nil)
+		transport.RoundTrip(req)
+	}()
+	defer close(release)
+
+	for len(transport.writes) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://ftd/api/fdm/v6/object/networks/1", nil)
+
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("waiting write returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
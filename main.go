@@ -13,4 +13,7 @@ func main() {
       return ftd.Provider()
     },
   })
+
+  // plugin.Serve returns once Terraform stops the provider
+  ftd.Shutdown()
 }
\ No newline at end of file